@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"flag"
 	"fmt"
 	"log"
 	"math/rand"
@@ -13,11 +15,14 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"ourstash/internal/grpcproto"
 	"ourstash/internal/stashdb"
+	"ourstash/internal/stashserver"
 )
 
 const (
@@ -70,7 +75,37 @@ func newOneRecord() oneRecord {
 }
 
 func main() {
-	conn, err := grpc.Dial(":3200", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	addr := flag.String("addr", stashserver.DefaultAddress, "address of the server")
+	user := flag.String("user", "admin", "user name")
+	password := flag.String("password", "", "user password, empty - the client certificate user")
+	useTLS := flag.Bool("tls", false, "connect with TLS, implied by the other tls flags")
+	tlsCA := flag.String("tls-ca", "", "CA bundle PEM file to verify the server, empty - system CAs")
+	tlsCert := flag.String("tls-cert", "", "client certificate PEM file for mutual TLS")
+	tlsKey := flag.String("tls-key", "", "client private key PEM file")
+	tlsServerName := flag.String("tls-server-name", "", "server name to verify, empty - the host of the address")
+	flag.Parse()
+
+	creds := insecure.NewCredentials()
+	if *useTLS || *tlsCA != "" || *tlsCert != "" || *tlsServerName != "" {
+		config := &tls.Config{ServerName: *tlsServerName, MinVersion: tls.VersionTLS12}
+		if *tlsCA != "" {
+			pool, err := stashserver.LoadCertPool(*tlsCA)
+			if err != nil {
+				log.Fatal(err)
+			}
+			config.RootCAs = pool
+		}
+		if *tlsCert != "" {
+			cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+			if err != nil {
+				log.Fatal(err)
+			}
+			config.Certificates = []tls.Certificate{cert}
+		}
+		creds = credentials.NewTLS(config)
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -81,6 +116,15 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
 	defer stop()
 
+	// without the password the server takes the user from the client certificate
+	if *password != "" {
+		login, err := c.Login(context.Background(), &grpcproto.LoginRequest{User: *user, Password: *password})
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+login.Token)
+	}
+
 	toGet := make(chan oneRecord, 10)
 	toUpdate := make(chan oneRecord, 10)
 	toRemove := make(chan oneRecord, 10)
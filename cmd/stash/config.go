@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+
+	"ourstash/internal/stashdb"
+	"ourstash/internal/stashserver"
+)
+
+// envPrefix the prefix of environment variables, the variable of the flag is STASH_ + the upper case flag name
+// with '-' replaced by '_', e.g. STASH_FSYNC_INTERVAL
+const envPrefix = "STASH_"
+
+// Config the settings of the server. Settings are taken from defaults, then from the YAML file (-config or
+// STASH_CONFIG), then from environment variables and then from flags.
+type Config struct {
+	Listen    string          `yaml:"listen"`
+	Data      DataConfig      `yaml:"data"`
+	Log       LogConfig       `yaml:"log"`
+	TLS       TLSConfig       `yaml:"tls"`
+	Limits    LimitsConfig    `yaml:"limits"`
+	Retention RetentionConfig `yaml:"retention"`
+	Admin     AdminConfig     `yaml:"admin"`
+}
+
+type DataConfig struct {
+	// Dir the directory of the write-ahead log, empty - in-memory only
+	Dir           string   `yaml:"dir"`
+	Fsync         string   `yaml:"fsync"`
+	FsyncInterval Duration `yaml:"fsync_interval"`
+}
+
+type LogConfig struct {
+	// Level debug, info, warn or error
+	Level string `yaml:"level"`
+	// Format console or json
+	Format string `yaml:"format"`
+}
+
+type TLSConfig struct {
+	Cert     string `yaml:"cert"`
+	Key      string `yaml:"key"`
+	ClientCA string `yaml:"client_ca"`
+}
+
+type LimitsConfig struct {
+	// MaxMessageSize the maximum size of the request and the response in bytes, 0 - the grpc default
+	MaxMessageSize int `yaml:"max_message_size"`
+	// MaxConcurrentStreams the maximum number of concurrent calls of the connection, 0 - unlimited
+	MaxConcurrentStreams uint `yaml:"max_concurrent_streams"`
+}
+
+type RetentionConfig struct {
+	SnapshotInterval Duration `yaml:"snapshot_interval"`
+	CompactInterval  Duration `yaml:"compact_interval"`
+	ReapInterval     Duration `yaml:"reap_interval"`
+	SessionTTL       Duration `yaml:"session_ttl"`
+}
+
+type AdminConfig struct {
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+}
+
+// Duration is time.Duration written as "1m30s" in the file
+type Duration time.Duration
+
+func (d Duration) MarshalYAML() (any, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	v, err := time.ParseDuration(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func defaultConfig() Config {
+	return Config{
+		Listen: stashserver.DefaultAddress,
+		Data: DataConfig{
+			Dir:           "data",
+			Fsync:         "always",
+			FsyncInterval: Duration(100 * time.Millisecond),
+		},
+		Log: LogConfig{
+			Level:  "debug",
+			Format: "console",
+		},
+		Retention: RetentionConfig{
+			SnapshotInterval: Duration(10 * time.Minute),
+			CompactInterval:  Duration(time.Hour),
+			ReapInterval:     Duration(time.Minute),
+			SessionTTL:       Duration(12 * time.Hour),
+		},
+		Admin: AdminConfig{
+			User: "admin",
+		},
+	}
+}
+
+// bindFlags registers flags of the config settings with defaults from cfg
+func bindFlags(fs *flag.FlagSet, cfg *Config) {
+	fs.StringVar(&cfg.Listen, "listen", cfg.Listen, "address the server listens on")
+
+	fs.StringVar(&cfg.Data.Dir, "data", cfg.Data.Dir, "directory of the write-ahead log, empty - in-memory only")
+	fs.StringVar(&cfg.Data.Fsync, "fsync", cfg.Data.Fsync, "wal fsync policy: always, interval or os")
+	fs.DurationVar((*time.Duration)(&cfg.Data.FsyncInterval), "fsync-interval", time.Duration(cfg.Data.FsyncInterval),
+		"wal fsync interval for the interval policy")
+
+	fs.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "log level: debug, info, warn or error")
+	fs.StringVar(&cfg.Log.Format, "log-format", cfg.Log.Format, "log format: console or json")
+
+	fs.StringVar(&cfg.TLS.Cert, "tls-cert", cfg.TLS.Cert, "server certificate PEM file, empty - no TLS")
+	fs.StringVar(&cfg.TLS.Key, "tls-key", cfg.TLS.Key, "server private key PEM file")
+	fs.StringVar(&cfg.TLS.ClientCA, "tls-client-ca", cfg.TLS.ClientCA, "CA bundle PEM file to verify required "+
+		"client certificates, the certificate common name is the user, empty - no client certificates")
+
+	fs.IntVar(&cfg.Limits.MaxMessageSize, "max-message-size", cfg.Limits.MaxMessageSize,
+		"maximum request and response size in bytes, 0 - the grpc default")
+	fs.UintVar(&cfg.Limits.MaxConcurrentStreams, "max-concurrent-streams", cfg.Limits.MaxConcurrentStreams,
+		"maximum concurrent calls of one connection, 0 - unlimited")
+
+	fs.DurationVar((*time.Duration)(&cfg.Retention.SnapshotInterval), "snapshot-interval",
+		time.Duration(cfg.Retention.SnapshotInterval), "snapshot interval, 0 - disabled")
+	fs.DurationVar((*time.Duration)(&cfg.Retention.CompactInterval), "compact-interval",
+		time.Duration(cfg.Retention.CompactInterval), "history compaction interval, 0 - disabled")
+	fs.DurationVar((*time.Duration)(&cfg.Retention.ReapInterval), "reap-interval",
+		time.Duration(cfg.Retention.ReapInterval), "expired records removal interval, 0 - disabled")
+	fs.DurationVar((*time.Duration)(&cfg.Retention.SessionTTL), "session-ttl",
+		time.Duration(cfg.Retention.SessionTTL), "how long the login session is valid")
+
+	fs.StringVar(&cfg.Admin.User, "admin-user", cfg.Admin.User,
+		"user created on start with the admin role on all sections if it doesn't exist")
+	fs.StringVar(&cfg.Admin.Password, "admin-password", cfg.Admin.Password, "password of admin-user, empty - don't create")
+}
+
+// loadConfig builds the config from defaults, the file, the environment and args, printConfig is set by -print-config
+func loadConfig(args []string) (cfg Config, printConfig bool, err error) {
+	cfg = defaultConfig()
+	fs := flag.NewFlagSet("stash", flag.ContinueOnError)
+	bindFlags(fs, &cfg)
+	file := fs.String("config", os.Getenv(envPrefix+"CONFIG"), "YAML config file, STASH_CONFIG")
+	fs.BoolVar(&printConfig, "print-config", false, "print the effective config and exit")
+	if err = fs.Parse(args); err != nil {
+		return cfg, false, err
+	}
+
+	// flags are applied again over the file and the environment
+	set := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = f.Value.String()
+	})
+
+	if *file != "" {
+		if err = cfg.readFile(*file); err != nil {
+			return cfg, false, err
+		}
+	}
+
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || f.Name == "config" || f.Name == "print-config" {
+			return
+		}
+		env := envPrefix + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if v, ok := os.LookupEnv(env); ok {
+			if setErr := fs.Set(f.Name, v); setErr != nil {
+				err = fmt.Errorf("%s: %w", env, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return cfg, false, err
+	}
+
+	for name, v := range set {
+		if err = fs.Set(name, v); err != nil {
+			return cfg, false, err
+		}
+	}
+	return cfg, printConfig, cfg.Validate()
+}
+
+// readFile overrides the config with settings of the YAML file, unknown settings are errors
+func (c *Config) readFile(file string) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err = dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config %s: %w", file, err)
+	}
+	return nil
+}
+
+// Validate returns all problems of the config in one error
+func (c Config) Validate() error {
+	var problems []string
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		add("listen: %s", err)
+	}
+
+	policy, err := stashdb.ParseSyncPolicy(c.Data.Fsync)
+	if err != nil {
+		add("data.fsync: %s", err)
+	}
+	if policy == stashdb.SyncInterval && c.Data.FsyncInterval <= 0 {
+		add("data.fsync_interval must be positive for the interval policy")
+	}
+
+	if _, err = zapcore.ParseLevel(c.Log.Level); err != nil {
+		add("log.level: %s", err)
+	}
+	if c.Log.Format != "console" && c.Log.Format != "json" {
+		add("log.format must be console or json, got %q", c.Log.Format)
+	}
+
+	if (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		add("tls.cert and tls.key must be set together")
+	}
+	if c.TLS.ClientCA != "" && c.TLS.Cert == "" {
+		add("tls.client_ca needs tls.cert and tls.key")
+	}
+	for name, file := range map[string]string{"tls.cert": c.TLS.Cert, "tls.key": c.TLS.Key, "tls.client_ca": c.TLS.ClientCA} {
+		if file == "" {
+			continue
+		}
+		if _, err = os.Stat(file); err != nil {
+			add("%s: %s", name, err)
+		}
+	}
+
+	if c.Limits.MaxMessageSize < 0 {
+		add("limits.max_message_size must not be negative")
+	}
+	if c.Limits.MaxConcurrentStreams > math.MaxUint32 {
+		add("limits.max_concurrent_streams must not exceed %d", uint32(math.MaxUint32))
+	}
+
+	for name, d := range map[string]Duration{
+		"retention.snapshot_interval": c.Retention.SnapshotInterval,
+		"retention.compact_interval":  c.Retention.CompactInterval,
+		"retention.reap_interval":     c.Retention.ReapInterval,
+	} {
+		if d < 0 {
+			add("%s must not be negative", name)
+		}
+	}
+	if c.Retention.SessionTTL <= 0 {
+		add("retention.session_ttl must be positive")
+	}
+
+	if c.Admin.Password != "" && c.Admin.User == "" {
+		add("admin.password needs admin.user")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("invalid config: %s", strings.Join(problems, "; "))
+}
+
+// String returns the config as YAML with the admin password hidden
+func (c Config) String() string {
+	if c.Admin.Password != "" {
+		c.Admin.Password = "***"
+	}
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+// newLogger builds the logger of the log settings
+func (c Config) newLogger() (*zap.Logger, error) {
+	zc := zap.NewDevelopmentConfig()
+	if c.Log.Format == "json" {
+		zc = zap.NewProductionConfig()
+	}
+	level, err := zap.ParseAtomicLevel(c.Log.Level)
+	if err != nil {
+		return nil, err
+	}
+	zc.Level = level
+	return zc.Build()
+}
+
+// stashOptions returns options of the Stash
+func (c Config) stashOptions() ([]stashdb.Option, error) {
+	policy, err := stashdb.ParseSyncPolicy(c.Data.Fsync)
+	if err != nil {
+		return nil, err
+	}
+	return []stashdb.Option{
+		stashdb.WithDataDir(c.Data.Dir),
+		stashdb.WithSyncPolicy(policy, time.Duration(c.Data.FsyncInterval)),
+		stashdb.WithSnapshotInterval(time.Duration(c.Retention.SnapshotInterval)),
+		stashdb.WithCompactInterval(time.Duration(c.Retention.CompactInterval)),
+		stashdb.WithReapInterval(time.Duration(c.Retention.ReapInterval)),
+		stashdb.WithSessionTTL(time.Duration(c.Retention.SessionTTL)),
+	}, nil
+}
+
+// serverOptions returns options of the StashServer
+func (c Config) serverOptions() []stashserver.Option {
+	opts := []stashserver.Option{
+		stashserver.WithAddress(c.Listen),
+		stashserver.WithLimits(c.Limits.MaxMessageSize, uint32(c.Limits.MaxConcurrentStreams)),
+	}
+	if c.TLS.Cert != "" {
+		opts = append(opts, stashserver.WithTLS(c.TLS.Cert, c.TLS.Key))
+	}
+	if c.TLS.ClientCA != "" {
+		opts = append(opts, stashserver.WithClientCA(c.TLS.ClientCA))
+	}
+	return opts
+}
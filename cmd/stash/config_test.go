@@ -0,0 +1,169 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "stash.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0600))
+	return file
+}
+
+func Test_loadConfig(t *testing.T) {
+	file := writeConfig(t, `
+listen: 127.0.0.1:4000
+data:
+  dir: /var/lib/stash
+  fsync: interval
+  fsync_interval: 50ms
+log:
+  level: info
+retention:
+  reap_interval: 30s
+`)
+
+	for _, tt := range []struct {
+		name  string
+		args  []string
+		env   map[string]string
+		check func(t *testing.T, cfg Config)
+	}{
+		{
+			name: "defaults",
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, defaultConfig(), cfg)
+			},
+		},
+		{
+			name: "file over defaults",
+			args: []string{"-config", file},
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, "127.0.0.1:4000", cfg.Listen)
+				require.Equal(t, "/var/lib/stash", cfg.Data.Dir)
+				require.Equal(t, "interval", cfg.Data.Fsync)
+				require.Equal(t, Duration(50*time.Millisecond), cfg.Data.FsyncInterval)
+				require.Equal(t, "info", cfg.Log.Level)
+				require.Equal(t, "console", cfg.Log.Format, "missing in the file")
+				require.Equal(t, Duration(30*time.Second), cfg.Retention.ReapInterval)
+				require.Equal(t, Duration(time.Hour), cfg.Retention.CompactInterval, "missing in the file")
+			},
+		},
+		{
+			name: "file from STASH_CONFIG",
+			env:  map[string]string{"STASH_CONFIG": file},
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, "127.0.0.1:4000", cfg.Listen)
+			},
+		},
+		{
+			name: "env over file",
+			args: []string{"-config", file},
+			env:  map[string]string{"STASH_LISTEN": ":5000", "STASH_FSYNC_INTERVAL": "70ms", "STASH_LOG_FORMAT": "json"},
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, ":5000", cfg.Listen)
+				require.Equal(t, Duration(70*time.Millisecond), cfg.Data.FsyncInterval)
+				require.Equal(t, "json", cfg.Log.Format)
+				require.Equal(t, "/var/lib/stash", cfg.Data.Dir, "not set in env")
+			},
+		},
+		{
+			name: "flags over env",
+			args: []string{"-config", file, "-listen", ":6000", "-reap-interval", "5s", "-max-message-size", "1024"},
+			env:  map[string]string{"STASH_LISTEN": ":5000", "STASH_REAP_INTERVAL": "10s", "STASH_LOG_LEVEL": "warn"},
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, ":6000", cfg.Listen)
+				require.Equal(t, Duration(5*time.Second), cfg.Retention.ReapInterval)
+				require.Equal(t, 1024, cfg.Limits.MaxMessageSize)
+				require.Equal(t, "warn", cfg.Log.Level, "not set by flags")
+			},
+		},
+		{
+			name: "flag set to the default value still wins",
+			args: []string{"-config", file, "-fsync", "always"},
+			check: func(t *testing.T, cfg Config) {
+				require.Equal(t, "always", cfg.Data.Fsync)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			cfg, printConfig, err := loadConfig(tt.args)
+			require.NoError(t, err)
+			require.False(t, printConfig)
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func Test_loadConfig_invalid(t *testing.T) {
+	certFile := writeConfig(t, "")
+
+	for _, tt := range []struct {
+		name string
+		file string
+		args []string
+		env  map[string]string
+		err  string
+	}{
+		{name: "unknown sync policy", args: []string{"-fsync", "never"}, err: `unknown sync policy "never"`},
+		{name: "unknown sync policy in env", env: map[string]string{"STASH_FSYNC": "never"}, err: `unknown sync policy "never"`},
+		{name: "bad duration flag", args: []string{"-reap-interval", "soon"}, err: "invalid value"},
+		{name: "bad duration in env", env: map[string]string{"STASH_REAP_INTERVAL": "soon"}, err: "STASH_REAP_INTERVAL"},
+		{name: "bad duration in file", file: "retention:\n  reap_interval: soon\n", err: "line 2"},
+		{name: "unknown key in file", file: "listen: :1\nport: 1\n", err: "field port not found"},
+		{name: "missing file", args: []string{"-config", "/nonexistent/stash.yaml"}, err: "no such file"},
+		{name: "tls cert without key", args: []string{"-tls-cert", certFile}, err: "tls.cert and tls.key must be set together"},
+		{name: "client ca without cert", args: []string{"-tls-client-ca", certFile}, err: "tls.client_ca needs tls.cert"},
+		{name: "missing cert file", args: []string{"-tls-cert", "/nonexistent.crt", "-tls-key", certFile}, err: "tls.cert"},
+		{name: "bad listen", args: []string{"-listen", "localhost"}, err: "listen:"},
+		{name: "bad log level", args: []string{"-log-level", "loud"}, err: "log.level"},
+		{name: "bad log format", args: []string{"-log-format", "xml"}, err: "log.format"},
+		{name: "interval policy without interval", args: []string{"-fsync", "interval", "-fsync-interval", "0"},
+			err: "data.fsync_interval"},
+		{name: "negative interval", args: []string{"-compact-interval", "-1s"}, err: "retention.compact_interval"},
+		{name: "zero session ttl", env: map[string]string{"STASH_SESSION_TTL": "0s"}, err: "retention.session_ttl"},
+		{name: "password without user", args: []string{"-admin-user", "", "-admin-password", "x"}, err: "admin.password"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if tt.file != "" {
+				args = append([]string{"-config", writeConfig(t, tt.file)}, args...)
+			}
+			_, _, err := loadConfig(args)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func Test_Config_String(t *testing.T) {
+	cfg := defaultConfig()
+	require.NotContains(t, cfg.String(), "***", "empty password is shown as is")
+
+	cfg.Admin.Password = "secret"
+	out := cfg.String()
+	require.NotContains(t, out, "secret")
+	require.Contains(t, out, "***")
+	require.Equal(t, "secret", cfg.Admin.Password, "the config itself is not changed")
+
+	var printed Config
+	require.NoError(t, yaml.Unmarshal([]byte(out), &printed))
+	printed.Admin.Password = cfg.Admin.Password
+	require.Equal(t, cfg, printed, "the printed config reads back")
+
+	_, printConfig, err := loadConfig([]string{"-print-config"})
+	require.NoError(t, err)
+	require.True(t, printConfig)
+}
@@ -2,24 +2,68 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"os"
 	"os/signal"
 	"sync"
 	"syscall"
-
-	"go.uber.org/zap"
+	"time"
 
 	"ourstash/internal/stashdb"
 	"ourstash/internal/stashserver"
 )
 
+// shutdownTimeout the time given to running calls to finish on stop
+const shutdownTimeout = 10 * time.Second
+
 func main() {
-	logger, err := zap.NewDevelopment() // or NewProduction, or NewDevelopment
+	cfg, printConfig, err := loadConfig(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if printConfig {
+		fmt.Print(cfg)
+		return
+	}
+
+	logger, err := cfg.newLogger()
+	if err != nil {
+		log.Fatal(err)
+	}
+	logger.Sugar().Infof("effective config:\n%s", cfg)
+
+	stashOpts, err := cfg.stashOptions()
 	if err != nil {
 		log.Fatal(err)
 	}
-	stash := stashdb.NewStash(logger)
-	s := stashserver.NewStashServer(stash, logger)
+	stash, err := stashdb.NewStash(logger, stashOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		if err := stash.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+	if cfg.Admin.Password != "" {
+		err = stash.CreateUser(cfg.Admin.User, cfg.Admin.Password)
+		if err == nil {
+			err = stash.Grant(cfg.Admin.User, stashdb.AllSections, stashdb.RoleAdmin)
+		}
+		if err != nil && !errors.Is(err, stashdb.ErrUserExists) {
+			log.Fatal(err)
+		}
+	}
+	if len(stash.ListUsers()) == 0 {
+		logger.Sugar().Warnw("no users, nobody can log in, set -admin-password")
+	}
+	s := stashserver.NewStashServer(stash, logger, cfg.serverOptions()...)
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
 	defer stop()
@@ -29,7 +73,19 @@ func main() {
 	go func(ctx context.Context) {
 		defer wg.Done()
 		<-ctx.Done()
-		s.GracefulStop()
+
+		stopped := make(chan struct{})
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			logger.Sugar().Warnw("graceful stop timed out, closing connections", "timeout", shutdownTimeout)
+			s.Stop()
+			<-stopped
+		}
 	}(ctx)
 
 	if err := s.Start(); err != nil {
@@ -0,0 +1,216 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// AggregateFunc the aggregate function
+type AggregateFunc byte
+
+const (
+	// AggCount the number of records with the field, all records of the group if the field is empty
+	AggCount AggregateFunc = iota
+	AggSum
+	AggMin
+	AggMax
+	AggAvg
+)
+
+var ErrInvalidAggregation = errors.New("invalid aggregation")
+
+// Aggregation the aggregate function over the numeric field
+type Aggregation struct {
+	Func  AggregateFunc
+	Field string
+}
+
+// AggregateResult the aggregated values of the group
+type AggregateResult struct {
+	// Group the values of group-by fields, nil for missing fields
+	Group map[string]any
+	// Count the number of records in the group
+	Count int
+	// Values the results in the order of aggregations, NaN if the group has no numeric values of the field
+	Values []float64
+}
+
+// String is Stringer implementation
+func (f AggregateFunc) String() string {
+	switch f {
+	case AggCount:
+		return "count"
+	case AggSum:
+		return "sum"
+	case AggMin:
+		return "min"
+	case AggMax:
+		return "max"
+	case AggAvg:
+		return "avg"
+	}
+	return "unknown"
+}
+
+// aggState the running state of the aggregation
+type aggState struct {
+	count    int
+	sum      float64
+	min, max float64
+}
+
+func (a Aggregation) validate() error {
+	switch a.Func {
+	case AggCount:
+		return nil
+	case AggSum, AggMin, AggMax, AggAvg:
+		if a.Field == "" {
+			return fmt.Errorf("%w: %s needs the field", ErrInvalidAggregation, a.Func)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unknown function %d", ErrInvalidAggregation, a.Func)
+}
+
+func (st *aggState) add(a Aggregation, data map[string]any) {
+	if a.Field == "" {
+		st.count++
+		return
+	}
+	value, ok := data[a.Field]
+	if !ok {
+		return
+	}
+	if a.Func == AggCount {
+		st.count++
+		return
+	}
+
+	v, ok := toFloat64(value)
+	if !ok {
+		return
+	}
+	if st.count == 0 || v < st.min {
+		st.min = v
+	}
+	if st.count == 0 || v > st.max {
+		st.max = v
+	}
+	st.sum += v
+	st.count++
+}
+
+func (st *aggState) result(f AggregateFunc) float64 {
+	if f == AggCount {
+		return float64(st.count)
+	}
+	if st.count == 0 {
+		return math.NaN()
+	}
+	switch f {
+	case AggSum:
+		return st.sum
+	case AggMin:
+		return st.min
+	case AggMax:
+		return st.max
+	case AggAvg:
+		return st.sum / float64(st.count)
+	}
+	return math.NaN()
+}
+
+// Aggregate computes aggregations over live records of the section matching the filter (see Filter),
+// grouped by values of groupBy fields. Numbers are summed as float64.
+// Groups are sorted by the group-by values.
+func (s *Stash) Aggregate(ctx context.Context, section SectionIdType, filter string, aggs []Aggregation, groupBy []string) ([]AggregateResult, error) {
+	for _, a := range aggs {
+		if err := a.validate(); err != nil {
+			return nil, err
+		}
+	}
+	f, err := ParseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	type group struct {
+		values []any
+		count  int
+		states []aggState
+	}
+	groups := make(map[string]*group)
+
+	err = s.match(ctx, section, f, func(_ GUIDType, data map[string]any) bool {
+		values := make([]any, len(groupBy))
+		var key strings.Builder
+		for i, field := range groupBy {
+			values[i] = data[field]
+			// int and int64 are one group, values decoded from the log are int64
+			if v, ok := indexValue(values[i]); ok {
+				values[i] = v
+			}
+			fmt.Fprintf(&key, "%T:%v\x00", values[i], values[i])
+		}
+
+		g, ok := groups[key.String()]
+		if !ok {
+			g = &group{values: values, states: make([]aggState, len(aggs))}
+			groups[key.String()] = g
+		}
+		g.count++
+		for i, a := range aggs {
+			g.states[i].add(a, data)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groupBy) == 0 && len(groups) == 0 {
+		// the aggregation over no records still has the result
+		groups[""] = &group{states: make([]aggState, len(aggs))}
+	}
+
+	sorted := make([]*group, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		for k := range groupBy {
+			a, b := sorted[i].values[k], sorted[j].values[k]
+			c, ok := compareValues(a, b)
+			if ok && c != 0 {
+				return c < 0
+			}
+			if !ok && valueRank(a) != valueRank(b) {
+				return valueRank(a) < valueRank(b)
+			}
+		}
+		return false
+	})
+
+	results := make([]AggregateResult, 0, len(sorted))
+	for _, g := range sorted {
+		res := AggregateResult{
+			Group:  make(map[string]any, len(groupBy)),
+			Count:  g.count,
+			Values: make([]float64, len(aggs)),
+		}
+		for i, field := range groupBy {
+			res.Group[field] = g.values[i]
+		}
+		for i, a := range aggs {
+			res.Values[i] = g.states[i].result(a.Func)
+		}
+		results = append(results, res)
+	}
+
+	s.sugar.Debugw("aggregate", "section", section, "filter", f, "groups", len(results))
+	return results, nil
+}
@@ -0,0 +1,83 @@
+package stashdb
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Aggregate(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, data := range []map[string]any{
+		{"customer": "alice", "tag": "#a", "amount": int64(10)},
+		{"customer": "alice", "tag": "#b", "amount": 2.5},
+		{"customer": "bob", "tag": "#a", "amount": int64(7)},
+		{"customer": "bob", "tag": "#a"},
+		{"tag": "#b", "amount": int64(1)},
+	} {
+		_, err = s.Insert(1, data)
+		require.NoError(t, err)
+	}
+	removed, err := s.Insert(1, map[string]any{"customer": "alice", "amount": int64(1000)})
+	require.NoError(t, err)
+	require.NoError(t, s.Remove(1, removed))
+
+	aggs := []Aggregation{
+		{Func: AggCount},
+		{Func: AggSum, Field: "amount"},
+		{Func: AggMin, Field: "amount"},
+		{Func: AggMax, Field: "amount"},
+		{Func: AggAvg, Field: "amount"},
+		{Func: AggCount, Field: "amount"},
+	}
+	results, err := s.Aggregate(ctx, 1, "", aggs, []string{"customer"})
+	require.NoError(t, err)
+	require.Equal(t, []AggregateResult{
+		{Group: map[string]any{"customer": "alice"}, Count: 2, Values: []float64{2, 12.5, 2.5, 10, 6.25, 2}},
+		{Group: map[string]any{"customer": "bob"}, Count: 2, Values: []float64{2, 7, 7, 7, 7, 1}},
+		{Group: map[string]any{"customer": nil}, Count: 1, Values: []float64{1, 1, 1, 1, 1, 1}},
+	}, results)
+
+	results, err = s.Aggregate(ctx, 1, `tag == "#a"`, []Aggregation{{Func: AggCount}}, []string{"tag", "customer"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, map[string]any{"tag": "#a", "customer": "alice"}, results[0].Group)
+	require.Equal(t, []float64{2}, results[1].Values)
+
+	results, err = s.Aggregate(ctx, 1, `tag == "#none"`, []Aggregation{{Func: AggCount}, {Func: AggAvg, Field: "amount"}}, nil)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Zero(t, results[0].Values[0])
+	require.True(t, math.IsNaN(results[0].Values[1]))
+
+	_, err = s.Aggregate(ctx, 1, "", []Aggregation{{Func: AggSum}}, nil)
+	require.ErrorIs(t, err, ErrInvalidAggregation)
+	_, err = s.Aggregate(ctx, 1, "amount >", aggs, nil)
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func Test_stash_Aggregate_numericGroup(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	for _, data := range []map[string]any{
+		{"n": 5, "amount": int64(1)},
+		{"n": int64(5), "amount": int64(2)},
+		{"n": 6, "amount": int64(4)},
+	} {
+		_, err = s.Insert(1, data)
+		require.NoError(t, err)
+	}
+
+	results, err := s.Aggregate(context.Background(), 1, "", []Aggregation{{Func: AggSum, Field: "amount"}}, []string{"n"})
+	require.NoError(t, err)
+	require.Equal(t, []AggregateResult{
+		{Group: map[string]any{"n": int64(5)}, Count: 2, Values: []float64{3}},
+		{Group: map[string]any{"n": int64(6)}, Count: 1, Values: []float64{4}},
+	}, results, "int and int64 of the same value are one group")
+}
@@ -0,0 +1,160 @@
+package stashdb
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WriteOption configures the single write
+type WriteOption func(*writeOptions)
+
+type writeOptions struct {
+	// ttl the time to live of the version, 0 - the section default, nil - the previous version expiration time
+	ttl *time.Duration
+	// user the author of the change
+	user string
+}
+
+// ByUser sets the author of the change, it is kept in the history and is reported by Audit
+func ByUser(name string) WriteOption {
+	return func(w *writeOptions) {
+		w.user = name
+	}
+}
+
+func newWriteOptions(opts []WriteOption) (writeOptions, error) {
+	var w writeOptions
+	for _, opt := range opts {
+		opt(&w)
+	}
+	if w.ttl != nil && *w.ttl < 0 {
+		return w, fmt.Errorf("%w: %s", ErrInvalidTTL, *w.ttl)
+	}
+	return w, nil
+}
+
+// AuditQuery selects changes for Audit
+type AuditQuery struct {
+	// Section the section of changes, AllSections - every section
+	Section SectionIdType
+	// User the author of changes, empty - any
+	User string
+	// From, To the time range of changes, To is exclusive, zero - unbounded
+	From time.Time
+	To   time.Time
+	// Limit the maximum number of changes, 0 - unlimited
+	Limit int
+}
+
+// Change the change of the record reported by Audit
+type Change struct {
+	Section   SectionIdType
+	Guid      GUIDType
+	Revision  uint64
+	Operation OperationType
+	User      string
+	Time      time.Time
+}
+
+func (q AuditQuery) match(user string, t time.Time) bool {
+	if q.User != "" && user != q.User {
+		return false
+	}
+	if !q.From.IsZero() && t.Before(q.From) {
+		return false
+	}
+	return q.To.IsZero() || t.Before(q.To)
+}
+
+// Audit returns changes matching the query sorted by time. Changes are read from the version chains of
+// live and removed records, so versions dropped by the versioning mode or the compaction are not reported.
+func (s *Stash) Audit(q AuditQuery) ([]Change, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sections := []SectionIdType{q.Section}
+	if q.Section == AllSections {
+		seen := make(map[SectionIdType]bool)
+		sections = sections[:0]
+		for _, registry := range []map[SectionIdType]map[GUIDType]Key{s.records, s.tombstones} {
+			for section := range registry {
+				if !seen[section] {
+					seen[section] = true
+					sections = append(sections, section)
+				}
+			}
+		}
+	}
+
+	var changes []Change
+	for _, section := range sections {
+		for _, registry := range []map[GUIDType]Key{s.records[section], s.tombstones[section]} {
+			for guid, key := range registry {
+				var err error
+				if changes, err = s.auditRecord(changes, key, guid, q); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if !a.Time.Equal(b.Time) {
+			return a.Time.Before(b.Time)
+		}
+		if a.Section != b.Section {
+			return a.Section < b.Section
+		}
+		if a.Guid != b.Guid {
+			return a.Guid < b.Guid
+		}
+		return a.Revision < b.Revision
+	})
+	if q.Limit > 0 && len(changes) > q.Limit {
+		changes = changes[:q.Limit]
+	}
+
+	s.sugar.Debugw("audit", "section", q.Section, "user", q.User, "changes", len(changes))
+	return changes, nil
+}
+
+// auditRecord appends changes of the record with the head version key matching the query
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) auditRecord(changes []Change, key Key, guid GUIDType, q AuditQuery) ([]Change, error) {
+	section := key.Section()
+	for {
+		header, err := s.getRecordHeader(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if q.match(header.user, header.time) {
+			changes = append(changes, Change{
+				Section:   section,
+				Guid:      guid,
+				Revision:  header.revision,
+				Operation: header.operation,
+				User:      header.user,
+				Time:      header.time,
+			})
+		}
+		if !header.removed.IsZero() && q.match(header.removedBy, header.removed) {
+			changes = append(changes, Change{
+				Section:   section,
+				Guid:      guid,
+				Revision:  header.revision,
+				Operation: RemoveOperation,
+				User:      header.removedBy,
+				Time:      header.removed,
+			})
+		}
+
+		if header.prev == 0 {
+			return changes, nil
+		}
+		key = NewKey(section, header.prev, headerFieldId)
+	}
+}
@@ -0,0 +1,79 @@
+package stashdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Audit(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	start := time.Now()
+	guid, err := s.Insert(1, map[string]any{"n": int64(1)}, ByUser("alice"))
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guid, map[string]any{"n": int64(2)}, ByUser("bob")))
+	other, err := s.Insert(2, map[string]any{"n": int64(3)}, ByUser("bob"))
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	middle := time.Now()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, s.Remove(1, guid, ByUser("carol")))
+	require.NoError(t, s.Restore(1, guid, ByUser("alice")))
+
+	tx := s.Begin(ByUser("dave"))
+	require.NoError(t, tx.Remove(2, other))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Equal(t, "alice", versions[0].User)
+	require.Equal(t, "bob", versions[1].User)
+
+	type change struct {
+		guid GUIDType
+		op   OperationType
+		user string
+	}
+	changesOf := func(changes []Change) []change {
+		res := make([]change, 0, len(changes))
+		for _, c := range changes {
+			res = append(res, change{c.Guid, c.Operation, c.User})
+		}
+		return res
+	}
+
+	changes, err := s.Audit(AuditQuery{Section: 1})
+	require.NoError(t, err)
+	require.Equal(t, []change{
+		{guid, InsertOperation, "alice"},
+		{guid, UpdateOperation, "bob"},
+		{guid, RemoveOperation, "carol"},
+		{guid, RestoreOperation, "alice"},
+	}, changesOf(changes))
+	require.EqualValues(t, 2, changes[2].Revision, "the removed revision")
+
+	changes, err = s.Audit(AuditQuery{User: "bob"})
+	require.NoError(t, err)
+	require.Equal(t, []change{{guid, UpdateOperation, "bob"}, {other, InsertOperation, "bob"}}, changesOf(changes))
+
+	changes, err = s.Audit(AuditQuery{From: middle})
+	require.NoError(t, err)
+	require.Equal(t, []change{
+		{guid, RemoveOperation, "carol"},
+		{guid, RestoreOperation, "alice"},
+		{other, RemoveOperation, "dave"},
+	}, changesOf(changes))
+
+	changes, err = s.Audit(AuditQuery{From: start, To: middle, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []change{{guid, InsertOperation, "alice"}, {guid, UpdateOperation, "bob"}}, changesOf(changes))
+}
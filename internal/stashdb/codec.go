@@ -0,0 +1,312 @@
+package stashdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// value tags of the binary encoding used by the write-ahead log
+const (
+	tagNil byte = iota
+	tagInt
+	tagInt64
+	tagString
+	tagBool
+	tagFloat64
+	tagBytes
+	tagCounter
+	tagHeader
+	tagUser
+)
+
+var errUnsupportedValue = errors.New("unsupported value type")
+
+// encodeValue appends the tagged binary representation of v to buf
+func encodeValue(buf *bytes.Buffer, v any) error {
+	switch val := v.(type) {
+	case nil:
+		buf.WriteByte(tagNil)
+	case int:
+		buf.WriteByte(tagInt)
+		putVarint(buf, int64(val))
+	case int64:
+		buf.WriteByte(tagInt64)
+		putVarint(buf, val)
+	case string:
+		buf.WriteByte(tagString)
+		putString(buf, val)
+	case bool:
+		buf.WriteByte(tagBool)
+		putBool(buf, val)
+	case float64:
+		buf.WriteByte(tagFloat64)
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], math.Float64bits(val))
+		buf.Write(b[:])
+	case []byte:
+		buf.WriteByte(tagBytes)
+		putBytes(buf, val)
+	case *uint64:
+		buf.WriteByte(tagCounter)
+		putUvarint(buf, *val)
+	case recordHeader:
+		buf.WriteByte(tagHeader)
+		encodeHeader(buf, val)
+	case userEntry:
+		buf.WriteByte(tagUser)
+		encodeUser(buf, val)
+	default:
+		return fmt.Errorf("%w: %T", errUnsupportedValue, v)
+	}
+	return nil
+}
+
+// decodeValue reads one tagged value written by encodeValue
+func decodeValue(r *bytes.Reader) (any, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagNil:
+		return nil, nil
+	case tagInt:
+		v, err := binary.ReadVarint(r)
+		return int(v), err
+	case tagInt64:
+		return binary.ReadVarint(r)
+	case tagString:
+		return getString(r)
+	case tagBool:
+		return getBool(r)
+	case tagFloat64:
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b[:])), nil
+	case tagBytes:
+		return getBytes(r)
+	case tagCounter:
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		return &v, nil
+	case tagHeader:
+		return decodeHeader(r)
+	case tagUser:
+		return decodeUser(r)
+	default:
+		return nil, fmt.Errorf("%w: tag %d", errUnsupportedValue, tag)
+	}
+}
+
+func encodeHeader(buf *bytes.Buffer, h recordHeader) {
+	putString(buf, string(h.guid))
+	putUvarint(buf, uint64(h.next))
+	putUvarint(buf, uint64(h.prev))
+	putUvarint(buf, h.revision)
+	putString(buf, string(h.operation))
+	putVarint(buf, h.time.UnixNano())
+	putTime(buf, h.removed)
+	putBool(buf, h.deleted)
+	putUvarint(buf, h.seq)
+	putUvarint(buf, h.removedSeq)
+	putTime(buf, h.expires)
+	putString(buf, h.user)
+	putString(buf, h.removedBy)
+}
+
+func decodeHeader(r *bytes.Reader) (recordHeader, error) {
+	var h recordHeader
+
+	guid, err := getString(r)
+	if err != nil {
+		return h, err
+	}
+	h.guid = GUIDType(guid)
+
+	next, err := binary.ReadUvarint(r)
+	if err != nil {
+		return h, err
+	}
+	h.next = RecordIdType(next)
+
+	prev, err := binary.ReadUvarint(r)
+	if err != nil {
+		return h, err
+	}
+	h.prev = RecordIdType(prev)
+
+	if h.revision, err = binary.ReadUvarint(r); err != nil {
+		return h, err
+	}
+
+	op, err := getString(r)
+	if err != nil {
+		return h, err
+	}
+	h.operation = OperationType(op)
+
+	nsec, err := binary.ReadVarint(r)
+	if err != nil {
+		return h, err
+	}
+	h.time = time.Unix(0, nsec)
+
+	if h.removed, err = getTime(r); err != nil {
+		return h, err
+	}
+
+	if h.deleted, err = getBool(r); err != nil {
+		return h, err
+	}
+
+	if h.seq, err = binary.ReadUvarint(r); err != nil {
+		return h, err
+	}
+	if h.removedSeq, err = binary.ReadUvarint(r); err != nil {
+		return h, err
+	}
+	if h.expires, err = getTime(r); err != nil {
+		return h, err
+	}
+	if h.user, err = getString(r); err != nil {
+		return h, err
+	}
+	h.removedBy, err = getString(r)
+	return h, err
+}
+
+func encodeUser(buf *bytes.Buffer, u userEntry) {
+	putString(buf, u.name)
+	putBytes(buf, u.salt)
+	putBytes(buf, u.hash)
+	putUvarint(buf, uint64(u.iterations))
+
+	sections := make([]SectionIdType, 0, len(u.grants))
+	for section := range u.grants {
+		sections = append(sections, section)
+	}
+	sort.Slice(sections, func(i, j int) bool { return sections[i] < sections[j] })
+	putUvarint(buf, uint64(len(sections)))
+	for _, section := range sections {
+		buf.WriteByte(byte(section))
+		buf.WriteByte(byte(u.grants[section]))
+	}
+}
+
+func decodeUser(r *bytes.Reader) (userEntry, error) {
+	var u userEntry
+	var err error
+
+	if u.name, err = getString(r); err != nil {
+		return u, err
+	}
+	if u.salt, err = getBytes(r); err != nil {
+		return u, err
+	}
+	if u.hash, err = getBytes(r); err != nil {
+		return u, err
+	}
+	iterations, err := binary.ReadUvarint(r)
+	if err != nil {
+		return u, err
+	}
+	u.iterations = int(iterations)
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return u, err
+	}
+	if n > uint64(r.Len()) {
+		return u, io.ErrUnexpectedEOF
+	}
+	u.grants = make(map[SectionIdType]Role, n)
+	for i := uint64(0); i < n; i++ {
+		var b [2]byte
+		if _, err = io.ReadFull(r, b[:]); err != nil {
+			return u, err
+		}
+		u.grants[SectionIdType(b[0])] = Role(b[1])
+	}
+	return u, nil
+}
+
+func putUvarint(buf *bytes.Buffer, v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	buf.Write(b[:n])
+}
+
+func putVarint(buf *bytes.Buffer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	buf.Write(b[:n])
+}
+
+func putBool(buf *bytes.Buffer, v bool) {
+	if v {
+		buf.WriteByte(1)
+		return
+	}
+	buf.WriteByte(0)
+}
+
+func putBytes(buf *bytes.Buffer, v []byte) {
+	putUvarint(buf, uint64(len(v)))
+	buf.Write(v)
+}
+
+func putString(buf *bytes.Buffer, v string) {
+	putUvarint(buf, uint64(len(v)))
+	buf.WriteString(v)
+}
+
+// putTime writes the zero time as 0 to keep it zero after decoding
+func putTime(buf *bytes.Buffer, v time.Time) {
+	if v.IsZero() {
+		putVarint(buf, 0)
+		return
+	}
+	putVarint(buf, v.UnixNano())
+}
+
+func getTime(r *bytes.Reader) (time.Time, error) {
+	nsec, err := binary.ReadVarint(r)
+	if err != nil || nsec == 0 {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nsec), nil
+}
+
+func getBool(r *bytes.Reader) (bool, error) {
+	b, err := r.ReadByte()
+	return b != 0, err
+}
+
+func getBytes(r *bytes.Reader) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if l > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, l)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+func getString(r *bytes.Reader) (string, error) {
+	b, err := getBytes(r)
+	return string(b), err
+}
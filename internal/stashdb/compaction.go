@@ -0,0 +1,176 @@
+package stashdb
+
+import (
+	"sort"
+	"time"
+)
+
+// CompactStats the result of the compaction run
+type CompactStats struct {
+	// Versions the number of dropped old versions
+	Versions int
+	// Tombstones the number of purged removed records
+	Tombstones int
+	// Keys the number of reclaimed keys
+	Keys     int
+	Duration time.Duration
+}
+
+// CompactMetrics the compactor metrics since the start
+type CompactMetrics struct {
+	Runs       uint64
+	Versions   uint64
+	Tombstones uint64
+	Keys       uint64
+	Last       CompactStats
+}
+
+func (c *CompactStats) add(other CompactStats) {
+	c.Versions += other.Versions
+	c.Tombstones += other.Tombstones
+	c.Keys += other.Keys
+}
+
+// Compact drops old versions and purges removed records of all sections according to their configs.
+// Every section is compacted under its own lock, so writers are not blocked for the whole run.
+func (s *Stash) Compact() (CompactStats, error) {
+	started := time.Now()
+
+	s.mu.RLock()
+	sections := make([]SectionIdType, 0, len(s.sections))
+	for section := range s.sections {
+		sections = append(sections, section)
+	}
+	s.mu.RUnlock()
+	sort.Slice(sections, func(i, j int) bool { return sections[i] < sections[j] })
+
+	var stats CompactStats
+	for _, section := range sections {
+		st, err := s.compactSection(section, started)
+		stats.add(st)
+		if err != nil {
+			return stats, err
+		}
+	}
+	stats.Duration = time.Since(started)
+
+	s.metricsMu.Lock()
+	s.metrics.Runs++
+	s.metrics.Versions += uint64(stats.Versions)
+	s.metrics.Tombstones += uint64(stats.Tombstones)
+	s.metrics.Keys += uint64(stats.Keys)
+	s.metrics.Last = stats
+	s.metricsMu.Unlock()
+
+	s.sugar.Infow("compaction",
+		"sections", len(sections),
+		"versions", stats.Versions,
+		"tombstones", stats.Tombstones,
+		"keys", stats.Keys,
+		"duration", stats.Duration,
+	)
+	return stats, nil
+}
+
+// CompactMetrics returns the compactor metrics
+func (s *Stash) CompactMetrics() CompactMetrics {
+	s.metricsMu.Lock()
+	defer s.metricsMu.Unlock()
+
+	return s.metrics
+}
+
+func (s *Stash) compactSection(section SectionIdType, now time.Time) (CompactStats, error) {
+	var stats CompactStats
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cfg := s.sections[section]
+	keep := cfg.keepVersions()
+	oldest := s.oldestView()
+
+	if cfg.TombstoneGrace > 0 {
+		deadline := now.Add(-cfg.TombstoneGrace)
+		for guid, key := range s.tombstones[section] {
+			header, err := s.getRecordHeader(key)
+			if err != nil {
+				return stats, err
+			}
+			if header.removed.After(deadline) || header.removedSeq > oldest {
+				continue
+			}
+			versions, keys, err := s.dropVersions(key)
+			stats.Versions += versions - 1
+			stats.Tombstones++
+			stats.Keys += keys
+			if err != nil {
+				return stats, err
+			}
+			delete(s.tombstones[section], guid)
+		}
+	}
+
+	if keep > 0 || cfg.Retention > 0 {
+		deadline := now.Add(-cfg.Retention)
+		for _, registry := range []map[GUIDType]Key{s.records[section], s.tombstones[section]} {
+			for _, key := range registry {
+				var versions, keys int
+				var err error
+				if keep > 0 {
+					versions, keys, err = s.prune(key, keep)
+					stats.Versions, stats.Keys = stats.Versions+versions, stats.Keys+keys
+					if err != nil {
+						return stats, err
+					}
+				}
+				if cfg.Retention > 0 {
+					versions, keys, err = s.retain(key, deadline, oldest)
+					stats.Versions, stats.Keys = stats.Versions+versions, stats.Keys+keys
+					if err != nil {
+						return stats, err
+					}
+				}
+			}
+		}
+	}
+
+	return stats, s.commit()
+}
+
+// retain drops versions of the record which were replaced before the deadline,
+// the version current at the deadline is kept to answer GetAsOf
+// and the version current at the oldest view sequence number is kept for the views
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) retain(key Key, deadline time.Time, oldest uint64) (int, int, error) {
+	header, err := s.getRecordHeader(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	for (header.time.After(deadline) || header.seq > oldest) && header.prev != 0 {
+		key = NewKey(key.Section(), header.prev, headerFieldId)
+		if header, err = s.getRecordHeader(key); err != nil {
+			return 0, 0, err
+		}
+	}
+	return s.cut(key, header)
+}
+
+// compactLoop runs the compaction every interval until the Stash is closed
+func (s *Stash) compactLoop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if _, err := s.Compact(); err != nil {
+				s.sugar.Errorw("compaction", "err", err)
+			}
+		}
+	}
+}
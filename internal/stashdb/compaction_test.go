@@ -0,0 +1,64 @@
+package stashdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Compact(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	guid, err := s.Insert(1, map[string]any{"int_val": 0})
+	require.NoError(t, err)
+	for i := 1; i < 3; i++ {
+		require.NoError(t, s.Update(1, guid, map[string]any{"int_val": i}))
+	}
+	removed, err := s.Insert(1, map[string]any{"int_val": 10})
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, removed, map[string]any{"int_val": 11}))
+	require.NoError(t, s.Remove(1, removed))
+	untouched, err := s.Insert(2, map[string]any{"int_val": 0})
+	require.NoError(t, err)
+	require.NoError(t, s.Update(2, untouched, map[string]any{"int_val": 1}))
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{
+		Retention:      5 * time.Millisecond,
+		TombstoneGrace: time.Hour,
+	}))
+
+	stats, err := s.Compact()
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.Versions, "2 old versions of guid and 1 of removed")
+	require.Equal(t, 0, stats.Tombstones)
+	require.Equal(t, 6, stats.Keys)
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.EqualValues(t, map[string]any{"int_val": 2}, versions[0].Data)
+
+	versions, err = s.History(2, untouched)
+	require.NoError(t, err)
+	require.Len(t, versions, 2, "section without config is not compacted")
+
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{TombstoneGrace: time.Millisecond}))
+	stats, err = s.Compact()
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Tombstones)
+	require.Equal(t, 2, stats.Keys)
+
+	_, err = s.History(1, removed)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	deleted, err := s.ListDeleted(1)
+	require.NoError(t, err)
+	require.Len(t, deleted, 0)
+
+	metrics := s.CompactMetrics()
+	require.EqualValues(t, 2, metrics.Runs)
+	require.EqualValues(t, 8, metrics.Keys)
+	require.Equal(t, stats, metrics.Last)
+}
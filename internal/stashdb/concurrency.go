@@ -0,0 +1,110 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrVersionConflict = errors.New("version conflict")
+
+// GetRevision returns data and the revision of the current version.
+// The revision grows by one on every change of the record and is used by UpdateIf, PatchIf and RemoveIf.
+func (s *Stash) GetRevision(section SectionIdType, guid GUIDType) (map[string]any, uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.liveKey(section, guid)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var header recordHeader
+	header, err = s.getRecordHeader(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var data map[string]any
+	data, err = s.getData(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, header.revision, nil
+}
+
+// UpdateIf is Update which fails with ErrVersionConflict if the current revision is not revision
+func (s *Stash) UpdateIf(section SectionIdType, guid GUIDType, data map[string]any, revision uint64, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.checkRevision(section, guid, revision); err != nil {
+		return err
+	}
+	if err = s.update(section, guid, UpdateOperation, data, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// PatchIf is Patch which fails with ErrVersionConflict if the current revision is not revision
+func (s *Stash) PatchIf(section SectionIdType, guid GUIDType, data map[string]any, remove []string, revision uint64, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.checkRevision(section, guid, revision); err != nil {
+		return err
+	}
+	if err = s.patch(section, guid, data, remove, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// RemoveIf is Remove which fails with ErrVersionConflict if the current revision is not revision
+func (s *Stash) RemoveIf(section SectionIdType, guid GUIDType, revision uint64, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.checkRevision(section, guid, revision); err != nil {
+		return err
+	}
+	if err = s.removeRecord(section, guid, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// checkRevision compares the revision of the current version with the expected one
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) checkRevision(section SectionIdType, guid GUIDType, expected uint64) error {
+	key, err := s.liveKey(section, guid)
+	if err != nil {
+		return err
+	}
+
+	var header recordHeader
+	header, err = s.getRecordHeader(key)
+	if err != nil {
+		return err
+	}
+	if header.revision != expected {
+		return fmt.Errorf("%w: expected revision %d, current %d", ErrVersionConflict, expected, header.revision)
+	}
+	return nil
+}
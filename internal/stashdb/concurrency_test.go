@@ -0,0 +1,40 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_UpdateIf(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	guid, err := s.Insert(1, map[string]any{"int_val": 1})
+	require.NoError(t, err)
+
+	data, revision, err := s.GetRevision(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, revision)
+	require.EqualValues(t, map[string]any{"int_val": 1}, data)
+
+	require.NoError(t, s.UpdateIf(1, guid, map[string]any{"int_val": 2}, revision))
+
+	err = s.UpdateIf(1, guid, map[string]any{"int_val": 3}, revision)
+	require.ErrorIs(t, err, ErrVersionConflict, "the record has moved on")
+	err = s.PatchIf(1, guid, map[string]any{"int_val": 3}, nil, revision)
+	require.ErrorIs(t, err, ErrVersionConflict)
+	require.ErrorIs(t, s.RemoveIf(1, guid, revision), ErrVersionConflict)
+
+	data, revision, err = s.GetRevision(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, revision)
+	require.EqualValues(t, map[string]any{"int_val": 2}, data)
+
+	require.NoError(t, s.PatchIf(1, guid, map[string]any{"tag": "#tag"}, nil, revision))
+	require.NoError(t, s.RemoveIf(1, guid, revision+1))
+
+	_, _, err = s.GetRevision(1, guid)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	require.ErrorIs(t, s.RemoveIf(1, guid, revision+1), ErrRecordNotFound)
+}
@@ -0,0 +1,447 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Filter the parsed filter expression, e.g. `tag == "#tag5" && int_value > 3 || text ~ "sample"`.
+//
+// Comparisons are `field op literal`, op is one of == != < <= > >= and ~ (the string contains the substring).
+// Literals are double-quoted strings, integer and float numbers, true and false.
+// Comparisons are combined by ! && || and parentheses, && binds tighter than ||.
+// A comparison with a missing field is false, numbers of all types are compared by value.
+type Filter struct {
+	src  string
+	root filterNode
+}
+
+type filterNode interface {
+	match(data map[string]any) bool
+	String() string
+}
+
+type andNode struct {
+	left, right filterNode
+}
+
+type orNode struct {
+	left, right filterNode
+}
+
+type notNode struct {
+	node filterNode
+}
+
+type cmpNode struct {
+	field string
+	op    string
+	value any
+}
+
+// ParseFilter parses the filter expression, the empty expression matches all records
+func ParseFilter(src string) (*Filter, error) {
+	p := filterParser{lexer: filterLexer{src: src}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokEOF {
+		return &Filter{src: src}, nil
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &Filter{src: src, root: root}, nil
+}
+
+// Match reports if the record data matches the filter
+func (f *Filter) Match(data map[string]any) bool {
+	if f == nil || f.root == nil {
+		return true
+	}
+	return f.root.match(data)
+}
+
+// String is Stringer implementation
+func (f *Filter) String() string {
+	if f == nil || f.root == nil {
+		return ""
+	}
+	return f.root.String()
+}
+
+func (n andNode) match(data map[string]any) bool {
+	return n.left.match(data) && n.right.match(data)
+}
+
+func (n andNode) String() string {
+	return "(" + n.left.String() + " && " + n.right.String() + ")"
+}
+
+func (n orNode) match(data map[string]any) bool {
+	return n.left.match(data) || n.right.match(data)
+}
+
+func (n orNode) String() string {
+	return "(" + n.left.String() + " || " + n.right.String() + ")"
+}
+
+func (n notNode) match(data map[string]any) bool {
+	return !n.node.match(data)
+}
+
+func (n notNode) String() string {
+	return "!" + n.node.String()
+}
+
+func (n cmpNode) match(data map[string]any) bool {
+	value, ok := data[n.field]
+	if !ok || value == nil {
+		return false
+	}
+
+	if n.op == "~" {
+		str, ok := value.(string)
+		return ok && strings.Contains(str, n.value.(string))
+	}
+
+	c, ok := compareValues(value, n.value)
+	switch n.op {
+	case "==":
+		return ok && c == 0
+	case "!=":
+		return !ok || c != 0
+	}
+	if !ok {
+		return false
+	}
+	if _, isBool := value.(bool); isBool {
+		return false
+	}
+	switch n.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func (n cmpNode) String() string {
+	if str, ok := n.value.(string); ok {
+		return n.field + " " + n.op + " " + strconv.Quote(str)
+	}
+	return fmt.Sprintf("%s %s %v", n.field, n.op, n.value)
+}
+
+// compareValues compares values of the same kind: numbers, strings or bools,
+// false if the values are not comparable
+func compareValues(a, b any) (int, bool) {
+	if ai, ok := toInt64(a); ok {
+		if bi, ok := toInt64(b); ok {
+			switch {
+			case ai < bi:
+				return -1, true
+			case ai > bi:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if af, ok := toFloat64(a); ok {
+		if bf, ok := toFloat64(b); ok {
+			switch {
+			case af < bf:
+				return -1, true
+			case af > bf:
+				return 1, true
+			}
+			return 0, true
+		}
+		return 0, false
+	}
+
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	case bool:
+		b, ok := b.(bool)
+		if !ok {
+			return 0, false
+		}
+		if a == b {
+			return 0, true
+		}
+		if !a {
+			return -1, true
+		}
+		return 1, true
+	}
+	return 0, false
+}
+
+func toInt64(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+type tokenKind byte
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// String is Stringer implementation
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of filter"
+	}
+	return strconv.Quote(t.text)
+}
+
+type filterLexer struct {
+	src string
+	pos int
+}
+
+func (l *filterLexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case c == '(':
+		l.pos++
+		return token{kind: tokLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokRParen, text: ")", pos: start}, nil
+	case c == '"':
+		l.pos++
+		for l.pos < len(l.src) && l.src[l.pos] != '"' {
+			if l.src[l.pos] == '\\' {
+				l.pos++
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return token{}, fmt.Errorf("%w: unterminated string at %d", ErrInvalidFilter, start)
+		}
+		l.pos++
+		str, err := strconv.Unquote(l.src[start:l.pos])
+		if err != nil {
+			return token{}, fmt.Errorf("%w: bad string at %d: %v", ErrInvalidFilter, start, err)
+		}
+		return token{kind: tokString, text: str, pos: start}, nil
+	case c == '-' || c >= '0' && c <= '9':
+		l.pos++
+		for l.pos < len(l.src) && (l.src[l.pos] >= '0' && l.src[l.pos] <= '9' || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return token{kind: tokNumber, text: l.src[start:l.pos], pos: start}, nil
+	case c == '_' || unicode.IsLetter(l.rune()):
+		for l.pos < len(l.src) {
+			r := l.rune()
+			if r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				break
+			}
+			l.pos += utf8.RuneLen(r)
+		}
+		return token{kind: tokIdent, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	for _, op := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "~"} {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, text: op, pos: start}, nil
+		}
+	}
+	return token{}, fmt.Errorf("%w: unexpected %q at %d", ErrInvalidFilter, c, start)
+}
+
+func (l *filterLexer) rune() rune {
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	return r
+}
+
+type filterParser struct {
+	lexer filterLexer
+	tok   token
+}
+
+func (p *filterParser) next() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at %d", ErrInvalidFilter, fmt.Sprintf(format, args...), p.tok.pos)
+}
+
+func (p *filterParser) isOp(op string) bool {
+	return p.tok.kind == tokOp && p.tok.text == op
+}
+
+// parseOr or := and ("||" and)*
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("||") {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		var right filterNode
+		if right, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd and := unary ("&&" unary)*
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("&&") {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		var right filterNode
+		if right, err = p.parseUnary(); err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary unary := "!" unary | "(" or ")" | field op literal
+func (p *filterParser) parseUnary() (filterNode, error) {
+	switch {
+	case p.isOp("!"):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	case p.tok.kind == tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, p.errorf("expected \")\", got %s", p.tok)
+		}
+		return node, p.next()
+	case p.tok.kind == tokIdent:
+		return p.parseCmp()
+	}
+	return nil, p.errorf("expected field, got %s", p.tok)
+}
+
+func (p *filterParser) parseCmp() (filterNode, error) {
+	node := cmpNode{field: p.tok.text}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.isOp("=="), p.isOp("!="), p.isOp("<"), p.isOp("<="), p.isOp(">"), p.isOp(">="), p.isOp("~"):
+		node.op = p.tok.text
+	default:
+		return nil, p.errorf("expected comparison, got %s", p.tok)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.tok.kind == tokString:
+		node.value = p.tok.text
+	case p.tok.kind == tokNumber:
+		if i, err := strconv.ParseInt(p.tok.text, 10, 64); err == nil {
+			node.value = i
+		} else if f, err := strconv.ParseFloat(p.tok.text, 64); err == nil {
+			node.value = f
+		} else {
+			return nil, p.errorf("bad number %s", p.tok)
+		}
+	case p.tok.kind == tokIdent && (p.tok.text == "true" || p.tok.text == "false"):
+		node.value = p.tok.text == "true"
+	default:
+		return nil, p.errorf("expected literal, got %s", p.tok)
+	}
+	if _, ok := node.value.(string); node.op == "~" && !ok {
+		return nil, p.errorf("~ expects string")
+	}
+
+	return node, p.next()
+}
@@ -0,0 +1,65 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseFilter(t *testing.T) {
+	data := map[string]any{
+		"tag":       "#tag5",
+		"int_value": 5,
+		"float":     2.5,
+		"text":      "some sample text",
+		"flag":      true,
+		"имя":       "значение",
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{``, true},
+		{`tag == "#tag5"`, true},
+		{`tag != "#tag5"`, false},
+		{`int_value > 3`, true},
+		{`int_value >= 5 && int_value <= 5`, true},
+		{`int_value < 5`, false},
+		{`float > 2`, true},
+		{`int_value == 5.0`, true},
+		{`text ~ "sample"`, true},
+		{`text ~ "other"`, false},
+		{`flag == true`, true},
+		{`flag > false`, false},
+		{`missing == 1`, false},
+		{`missing != 1`, false},
+		{`tag == 5`, false},
+		{`tag != 5`, true},
+		{`tag == "#tag1" && int_value > 3 || text ~ "sample"`, true},
+		{`tag == "#tag1" && (int_value > 3 || text ~ "sample")`, false},
+		{`!(tag == "#tag1") && !flag == false`, true},
+		{`имя == "значение"`, true},
+		{`text == "quote \" inside"`, false},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.filter)
+		require.NoError(t, err, tt.filter)
+		require.Equal(t, tt.want, f.Match(data), tt.filter)
+	}
+
+	for _, bad := range []string{
+		`tag`,
+		`tag ==`,
+		`tag == "open`,
+		`== 5`,
+		`(tag == 1`,
+		`tag == 1 tag == 2`,
+		`tag ~ 5`,
+		`tag == 1 & flag == true`,
+		`tag == 1.2.3`,
+	} {
+		_, err := ParseFilter(bad)
+		require.ErrorIs(t, err, ErrInvalidFilter, bad)
+	}
+}
@@ -0,0 +1,342 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// the full-text index definitions are stored in the system section, record textIndexesRecordId + section,
+// the field id is the id of the indexed field, the value is its name
+const textIndexesRecordId RecordIdType = 0x400
+
+var ErrInvalidSearch = errors.New("invalid search query")
+
+// SearchResult the record found by the full-text search
+type SearchResult struct {
+	Guid GUIDType
+	// Score the number of occurrences of query terms in the field
+	Score int
+}
+
+// textIndex the inverted index of the string field: term -> record -> term positions
+type textIndex struct {
+	postings map[string]map[GUIDType][]int
+	// terms holds the distinct terms of every record to drop its postings on update and remove
+	terms map[GUIDType][]string
+}
+
+func newTextIndex() *textIndex {
+	return &textIndex{
+		postings: make(map[string]map[GUIDType][]int),
+		terms:    make(map[GUIDType][]string),
+	}
+}
+
+// tokenize splits the text into lowercase stemmed terms
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = stem(word)
+	}
+	return words
+}
+
+// stemRules the suffixes stripped by stem and their replacements, the first matching rule is applied
+var stemRules = [][2]string{
+	{"sses", "ss"},
+	{"ies", "y"},
+	{"ches", "ch"},
+	{"shes", "sh"},
+	{"xes", "x"},
+	{"zes", "z"},
+	{"ss", "ss"},
+	{"s", ""},
+	{"ing", ""},
+	{"ed", ""},
+	{"ly", ""},
+}
+
+// stem strips common English suffixes, the stem is kept at least 3 letters long
+func stem(word string) string {
+	for _, rule := range stemRules {
+		if strings.HasSuffix(word, rule[0]) {
+			base := strings.TrimSuffix(word, rule[0]) + rule[1]
+			if len([]rune(base)) >= 3 {
+				return base
+			}
+			return word
+		}
+	}
+	return word
+}
+
+// put indexes the text of the record, the previous text of the record is dropped
+func (idx *textIndex) put(guid GUIDType, value any) {
+	idx.drop(guid)
+
+	text, ok := value.(string)
+	if !ok {
+		return
+	}
+	var distinct []string
+	for pos, term := range tokenize(text) {
+		docs, ok := idx.postings[term]
+		if !ok {
+			docs = make(map[GUIDType][]int)
+			idx.postings[term] = docs
+		}
+		if len(docs[guid]) == 0 {
+			distinct = append(distinct, term)
+		}
+		docs[guid] = append(docs[guid], pos)
+	}
+	if len(distinct) > 0 {
+		idx.terms[guid] = distinct
+	}
+}
+
+// drop removes the record from the index
+func (idx *textIndex) drop(guid GUIDType) {
+	for _, term := range idx.terms[guid] {
+		delete(idx.postings[term], guid)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.terms, guid)
+}
+
+// phrase returns the number of occurrences of terms going one after another in the record
+func (idx *textIndex) phrase(guid GUIDType, terms []string) int {
+	var count int
+	for _, start := range idx.postings[terms[0]][guid] {
+		found := true
+		for i, term := range terms[1:] {
+			if !containsInt(idx.postings[term][guid], start+i+1) {
+				found = false
+				break
+			}
+		}
+		if found {
+			count++
+		}
+	}
+	return count
+}
+
+func containsInt(sorted []int, v int) bool {
+	i := sort.SearchInts(sorted, v)
+	return i < len(sorted) && sorted[i] == v
+}
+
+// searchTerm the word or the phrase of the search query
+type searchTerm []string
+
+// parseSearch parses the query: words and "quoted phrases" are joined by AND, OR separates alternatives
+func parseSearch(query string) ([][]searchTerm, error) {
+	var alternatives [][]searchTerm
+	var group []searchTerm
+
+	flush := func() error {
+		if len(group) == 0 {
+			return fmt.Errorf("%w: empty alternative in %q", ErrInvalidSearch, query)
+		}
+		alternatives = append(alternatives, group)
+		group = nil
+		return nil
+	}
+
+	rest := query
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+
+		if rest[0] == '"' {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated phrase in %q", ErrInvalidSearch, query)
+			}
+			if terms := tokenize(rest[1 : end+1]); len(terms) > 0 {
+				group = append(group, terms)
+			}
+			rest = rest[end+2:]
+			continue
+		}
+
+		word := rest
+		if end := strings.IndexFunc(rest, func(r rune) bool { return unicode.IsSpace(r) || r == '"' }); end >= 0 {
+			word = rest[:end]
+		}
+		rest = rest[len(word):]
+
+		switch word {
+		case "OR":
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		case "AND":
+		default:
+			// the word split by punctuation, e.g. e-mail, is the phrase
+			if terms := tokenize(word); len(terms) > 0 {
+				group = append(group, terms)
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return alternatives, nil
+}
+
+// search returns scores of records matching any of alternatives
+func (idx *textIndex) search(alternatives [][]searchTerm) map[GUIDType]int {
+	scores := make(map[GUIDType]int)
+	for _, group := range alternatives {
+		var matched map[GUIDType]int
+		for _, term := range group {
+			found := make(map[GUIDType]int)
+			for guid, positions := range idx.postings[term[0]] {
+				if matched != nil {
+					if _, ok := matched[guid]; !ok {
+						continue
+					}
+				}
+				score := len(positions)
+				if len(term) > 1 {
+					score = idx.phrase(guid, term)
+				}
+				if score > 0 {
+					found[guid] = matched[guid] + score
+				}
+			}
+			matched = found
+			if len(matched) == 0 {
+				break
+			}
+		}
+		for guid, score := range matched {
+			if score > scores[guid] {
+				scores[guid] = score
+			}
+		}
+	}
+	return scores
+}
+
+func textIndexKey(section SectionIdType, field FieldIdType) Key {
+	return NewKey(metadataSection, textIndexesRecordId+RecordIdType(section), field)
+}
+
+// CreateTextIndex declares the full-text index on the string field of the section and fills it with live records
+func (s *Stash) CreateTextIndex(section SectionIdType, field string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.textIndexes[section][field]; ok {
+		return fmt.Errorf("%w: text %s", ErrIndexExists, field)
+	}
+
+	if err := s.buildTextIndex(section, field); err != nil {
+		return err
+	}
+	s.store(textIndexKey(section, s.fieldIdSFG(section, field)), field)
+
+	s.sugar.Debugw("text index created", "section", section, "field", field)
+	return s.commit()
+}
+
+// DropTextIndex removes the full-text index on the field of the section
+func (s *Stash) DropTextIndex(section SectionIdType, field string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.textIndexes[section][field]; !ok {
+		return fmt.Errorf("%w: text %s", ErrIndexNotFound, field)
+	}
+
+	s.unstore(textIndexKey(section, s.fieldIdSFG(section, field)))
+	delete(s.textIndexes[section], field)
+
+	s.sugar.Debugw("text index dropped", "section", section, "field", field)
+	return s.commit()
+}
+
+// Search returns live records of the section with the field matching the query ranked by the term frequency.
+// The query is words and "quoted phrases" which all must be found, OR separates alternatives,
+// e.g. `sample text OR "exact phrase"`. Words are lowercased and stemmed.
+func (s *Stash) Search(section SectionIdType, field string, query string) ([]SearchResult, error) {
+	alternatives, err := parseSearch(query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx, ok := s.textIndexes[section][field]
+	if !ok {
+		return nil, fmt.Errorf("%w: text %s", ErrIndexNotFound, field)
+	}
+
+	scores := idx.search(alternatives)
+	results := make([]SearchResult, 0, len(scores))
+	for guid, score := range scores {
+		if _, err = s.liveKey(section, guid); err != nil {
+			// expired records are in the index until the reaper removes them
+			continue
+		}
+		results = append(results, SearchResult{Guid: guid, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Guid < results[j].Guid
+	})
+
+	s.sugar.Debugw("search", "section", section, "field", field, "query", query, "results", len(results))
+	return results, nil
+}
+
+// buildTextIndex fills the full-text index on the field with live records of the section
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) buildTextIndex(section SectionIdType, field string) error {
+	idx := newTextIndex()
+	for guid, key := range s.records[section] {
+		data, err := s.getData(key)
+		if err != nil {
+			return err
+		}
+		idx.put(guid, data[field])
+	}
+
+	if s.textIndexes[section] == nil {
+		s.textIndexes[section] = make(map[string]*textIndex)
+	}
+	s.textIndexes[section][field] = idx
+	return nil
+}
+
+// loadTextIndex restores the full-text index definition from the system section,
+// the index is built by buildIndexes
+func (s *Stash) loadTextIndex(key Key, value any) error {
+	section := SectionIdType(key.Record() - textIndexesRecordId)
+	field, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("text index field is not string (key %s)", key)
+	}
+
+	if s.textIndexes[section] == nil {
+		s.textIndexes[section] = make(map[string]*textIndex)
+	}
+	s.textIndexes[section][field] = newTextIndex()
+	return nil
+}
@@ -0,0 +1,67 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_tokenize(t *testing.T) {
+	require.Equal(t,
+		[]string{"the", "quick", "brown", "fox", "jump", "over", "lazy", "dog", "class", "story"},
+		tokenize("The QUICK, brown fox jumped over lazy dogs: classes stories"),
+	)
+	require.Equal(t, []string{"привет", "мир"}, tokenize("Привет, мир!"))
+}
+
+func Test_stash_Search(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	first, err := s.Insert(1, map[string]any{"text": "Sample text about quick foxes"})
+	require.NoError(t, err)
+	second, err := s.Insert(1, map[string]any{"text": "Another sample, sample text. Samples everywhere"})
+	require.NoError(t, err)
+	require.NoError(t, s.CreateTextIndex(1, "text"))
+	third, err := s.Insert(1, map[string]any{"text": "text sample in reverse order"})
+	require.NoError(t, err)
+	require.Contains(t, s.ListIndexes(1), IndexInfo{Field: "text", Text: true})
+
+	search := func(query string) []SearchResult {
+		results, err := s.Search(1, "text", query)
+		require.NoError(t, err, query)
+		return results
+	}
+
+	results := search("sample AND text")
+	require.Equal(t, second, results[0].Guid, "ranked by term frequency")
+	require.Equal(t, map[GUIDType]int{second: 4, first: 2, third: 2}, scores(results))
+	require.Equal(t, map[GUIDType]int{second: 1, first: 1}, scores(search(`"sample text"`)))
+	require.Equal(t, []SearchResult{{first, 1}}, search("fox"), "stemmed")
+	require.Len(t, search("fox OR reverse"), 2)
+	require.Empty(t, search("fox reverse"))
+
+	require.NoError(t, s.Update(1, first, map[string]any{"text": "nothing to see"}))
+	require.Empty(t, search("fox"))
+	require.NoError(t, s.Remove(1, third))
+	require.Empty(t, search("reverse"))
+
+	_, err = s.Search(1, "text", `"open phrase`)
+	require.ErrorIs(t, err, ErrInvalidSearch)
+	_, err = s.Search(1, "text", `fox OR`)
+	require.ErrorIs(t, err, ErrInvalidSearch)
+	_, err = s.Search(1, "other", "fox")
+	require.ErrorIs(t, err, ErrIndexNotFound)
+
+	require.NoError(t, s.DropTextIndex(1, "text"))
+	_, err = s.Search(1, "text", "fox")
+	require.ErrorIs(t, err, ErrIndexNotFound)
+}
+
+func scores(results []SearchResult) map[GUIDType]int {
+	res := make(map[GUIDType]int, len(results))
+	for _, r := range results {
+		res[r.Guid] = r.Score
+	}
+	return res
+}
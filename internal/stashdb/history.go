@@ -0,0 +1,160 @@
+package stashdb
+
+import (
+	"time"
+)
+
+// Version the single version of the record from its history
+type Version struct {
+	Revision  uint64
+	RecordId  RecordIdType
+	Operation OperationType
+	// User the author of the version
+	User    string
+	Time    time.Time
+	Deleted bool
+	Data    map[string]any
+}
+
+// History returns all versions of the record from the oldest to the newest,
+// removed records have the history too
+func (s *Stash) History(section SectionIdType, guid GUIDType) ([]Version, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.lastRecordKey(section, guid)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	for {
+		var header recordHeader
+		header, err = s.getRecordHeader(key)
+		if err != nil {
+			return nil, err
+		}
+
+		var data map[string]any
+		data, err = s.getData(key)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, Version{
+			Revision:  header.revision,
+			RecordId:  key.Record(),
+			Operation: header.operation,
+			User:      header.user,
+			Time:      header.time,
+			Deleted:   header.deleted,
+			Data:      data,
+		})
+
+		if header.prev == 0 {
+			break
+		}
+		key = NewKey(section, header.prev, headerFieldId)
+	}
+
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	return versions, nil
+}
+
+// GetAsOf returns the version of the record which was current at t.
+// ErrRecordNotFound if the record did not exist yet or was already removed at t.
+func (s *Stash) GetAsOf(section SectionIdType, guid GUIDType, t time.Time) (map[string]any, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.lastRecordKey(section, guid)
+	if err != nil {
+		return nil, err
+	}
+
+	var header recordHeader
+	header, err = s.getRecordHeader(key)
+	if err != nil {
+		return nil, err
+	}
+
+	for header.time.After(t) {
+		if header.prev == 0 {
+			return nil, ErrRecordNotFound
+		}
+		key = NewKey(section, header.prev, headerFieldId)
+		header, err = s.getRecordHeader(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !header.removed.IsZero() && !header.removed.After(t) {
+		return nil, ErrRecordNotFound
+	}
+	s.sugar.Debugw("get as of", "guid", guid, "time", t, "key", key)
+
+	return s.getData(key)
+}
+
+// Revert writes the new version of the live record with the fields copied from the revision,
+// the new version has RevertOperation in the history
+func (s *Stash) Revert(section SectionIdType, guid GUIDType, revision uint64, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := s.recordKeySFG(section, guid)
+	if err != nil {
+		return err
+	}
+
+	var header recordHeader
+	header, err = s.getRecordHeader(key)
+	if err != nil {
+		return err
+	}
+	for header.revision != revision {
+		if header.prev == 0 || header.revision < revision {
+			return ErrVersionNotFound
+		}
+		key = NewKey(section, header.prev, headerFieldId)
+		header, err = s.getRecordHeader(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	var data map[string]any
+	data, err = s.getData(key)
+	if err != nil {
+		return err
+	}
+
+	if err = s.update(section, guid, RevertOperation, data, w); err != nil {
+		return err
+	}
+	s.sugar.Debugw("revert", "guid", guid, "revision", revision, "key", key)
+	return s.commit()
+}
+
+// lastRecordKey returns the header key of the newest version of the live or removed record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) lastRecordKey(section SectionIdType, guid GUIDType) (Key, error) {
+	key, err := s.recordKeySFG(section, guid)
+	if err == nil {
+		return key, nil
+	}
+
+	key, ok := s.tombstones[section][guid]
+	if !ok {
+		return Key{}, ErrRecordNotFound
+	}
+	return key, nil
+}
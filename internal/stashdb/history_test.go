@@ -0,0 +1,126 @@
+package stashdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_History(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	v1 := map[string]any{"tag": "#tag1", "int_val": 1}
+	v2 := map[string]any{"tag": "#tag2"}
+	v3 := map[string]any{"tag": "#tag3", "text": "sample text"}
+
+	guid, err := s.Insert(1, v1, ByUser("alice"))
+	require.NoError(t, err)
+	other, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guid, v2, ByUser("bob")))
+	require.NoError(t, s.Update(1, other, v2))
+	require.NoError(t, s.Update(1, guid, v3))
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+
+	for i, want := range []map[string]any{v1, v2, v3} {
+		require.EqualValues(t, i+1, versions[i].Revision)
+		require.EqualValues(t, want, versions[i].Data)
+		require.Equal(t, []string{"alice", "bob", ""}[i], versions[i].User)
+		require.Equal(t, i != 2, versions[i].Deleted)
+		if i > 0 {
+			require.Equal(t, UpdateOperation, versions[i].Operation)
+			require.True(t, versions[i].RecordId > versions[i-1].RecordId)
+			require.False(t, versions[i].Time.Before(versions[i-1].Time))
+		}
+	}
+	require.Equal(t, InsertOperation, versions[0].Operation)
+
+	v := s.Snapshot()
+	defer v.Close()
+	viewed, err := v.History(1, guid)
+	require.NoError(t, err)
+	require.Equal(t, versions, viewed, "the view returns the same history")
+
+	require.NoError(t, s.Remove(1, guid))
+	viewed, err = v.History(1, guid)
+	require.NoError(t, err)
+	require.Equal(t, versions, viewed, "the view doesn't see the removal")
+	versions, err = s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 3, "removed record keeps its history")
+	require.True(t, versions[2].Deleted)
+
+	_, err = s.History(1, "unknown")
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func Test_stash_GetAsOf(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	v1 := map[string]any{"tag": "#tag1"}
+	v2 := map[string]any{"tag": "#tag2"}
+
+	beforeInsert := time.Now()
+	time.Sleep(time.Millisecond)
+	guid, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	afterInsert := time.Now()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, s.Update(1, guid, v2))
+	time.Sleep(time.Millisecond)
+	afterUpdate := time.Now()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, s.Remove(1, guid))
+	time.Sleep(time.Millisecond)
+	afterRemove := time.Now()
+
+	_, err = s.GetAsOf(1, guid, beforeInsert)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	from, err := s.GetAsOf(1, guid, afterInsert)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, from)
+
+	from, err = s.GetAsOf(1, guid, afterUpdate)
+	require.NoError(t, err)
+	require.EqualValues(t, v2, from)
+
+	_, err = s.GetAsOf(1, guid, afterRemove)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func Test_stash_Revert(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	v1 := map[string]any{"tag": "#tag1", "int_val": 1}
+	v2 := map[string]any{"tag": "#tag2"}
+
+	guid, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guid, v2))
+
+	require.NoError(t, s.Revert(1, guid, 1))
+
+	from, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, from)
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	require.Equal(t, RevertOperation, versions[2].Operation)
+	require.EqualValues(t, 3, versions[2].Revision)
+
+	require.ErrorIs(t, s.Revert(1, guid, 10), ErrVersionNotFound)
+
+	require.NoError(t, s.Remove(1, guid))
+	require.ErrorIs(t, s.Revert(1, guid, 1), ErrRecordNotFound)
+}
@@ -0,0 +1,366 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// the index definitions are stored in the system section, record indexesRecordId + section
+// or uniqueIndexesRecordId + section, the field id is the id of the indexed field, the value is its name
+const (
+	indexesRecordId       RecordIdType = 0x200
+	uniqueIndexesRecordId RecordIdType = 0x300
+)
+
+var (
+	ErrIndexNotFound = errors.New("index not found")
+	ErrIndexExists   = errors.New("index already exists")
+)
+
+// IndexInfo the index declared on the field of the section
+type IndexInfo struct {
+	Field  string
+	Unique bool
+	// Text the full-text index, see CreateTextIndex
+	Text bool
+}
+
+// indexEntry the indexed value of the live record
+type indexEntry struct {
+	value any
+	guid  GUIDType
+}
+
+// index the secondary index on the field of the section, holds int64 and string values
+// of live records sorted by value and guid
+type index struct {
+	// unique no two live records may have the same value
+	unique  bool
+	entries redBlackTree[indexEntry]
+	// values holds the indexed value of every record to drop the entry on update and remove
+	values map[GUIDType]any
+	// other holds records with the field value of not indexed type, queries check them separately
+	other map[GUIDType]struct{}
+}
+
+func newIndex(unique bool) *index {
+	return &index{
+		unique: unique,
+		values: make(map[GUIDType]any),
+		other:  make(map[GUIDType]struct{}),
+	}
+}
+
+// indexValue returns the value as it is kept by the index, int is stored as int64.
+// false if the value type is not indexed.
+func indexValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int64, string:
+		return v, true
+	}
+	return nil, false
+}
+
+// compareIndexValues compares indexed values, all int64 values are less than string values
+func compareIndexValues(a, b any) int {
+	switch a := a.(type) {
+	case int64:
+		b, ok := b.(int64)
+		switch {
+		case !ok || a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 1
+		}
+		return strings.Compare(a, b)
+	}
+	return 0
+}
+
+// Compare orders entries by value and guid
+func (e indexEntry) Compare(other indexEntry) int {
+	if c := compareIndexValues(e.value, other.value); c != 0 {
+		return c
+	}
+	return strings.Compare(string(e.guid), string(other.guid))
+}
+
+// put indexes the field value of the record, the previous value of the record is dropped
+func (idx *index) put(guid GUIDType, value any) {
+	idx.drop(guid)
+
+	v, ok := indexValue(value)
+	if !ok {
+		if value != nil {
+			idx.other[guid] = struct{}{}
+		}
+		return
+	}
+	idx.entries.put(indexEntry{value: v, guid: guid})
+	idx.values[guid] = v
+}
+
+// drop removes the record from the index
+func (idx *index) drop(guid GUIDType) {
+	delete(idx.other, guid)
+	v, ok := idx.values[guid]
+	if !ok {
+		return
+	}
+	delete(idx.values, guid)
+	idx.entries.remove(indexEntry{value: v, guid: guid})
+}
+
+// holders returns guids of records with the value
+func (idx *index) holders(value any) []GUIDType {
+	v, ok := indexValue(value)
+	if !ok {
+		return nil
+	}
+	return idx.scan(v, v)
+}
+
+// scan returns guids of records with values from..to inclusive, nil bound is open
+func (idx *index) scan(from, to any) []GUIDType {
+	it := idx.entries.iterator()
+	if from != nil {
+		// the empty guid is less than any other, so the first entry with the value is found
+		if it = idx.entries.iteratorAt(idx.entries.ceiling(indexEntry{value: from})); it.pos != onmyway {
+			return nil
+		}
+	} else if !it.next() {
+		return nil
+	}
+
+	var guids []GUIDType
+	for ; it.pos == onmyway; it.next() {
+		if to != nil && compareIndexValues(it.node.key.value, to) > 0 {
+			break
+		}
+		guids = append(guids, it.node.key.guid)
+	}
+	return guids
+}
+
+func indexKey(section SectionIdType, field FieldIdType, unique bool) Key {
+	if unique {
+		return NewKey(metadataSection, uniqueIndexesRecordId+RecordIdType(section), field)
+	}
+	return NewKey(metadataSection, indexesRecordId+RecordIdType(section), field)
+}
+
+// CreateIndex declares the index on the field of the section and fills it with live records
+func (s *Stash) CreateIndex(section SectionIdType, field string) error {
+	return s.createIndex(section, field, false)
+}
+
+// CreateUniqueIndex declares the unique constraint on the field of the section,
+// ErrUniqueViolation if live records already have equal values.
+// The constraint is checked for live records only, older versions and removed records are exempt.
+func (s *Stash) CreateUniqueIndex(section SectionIdType, field string) error {
+	return s.createIndex(section, field, true)
+}
+
+func (s *Stash) createIndex(section SectionIdType, field string, unique bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.indexes[section][field]; ok {
+		return fmt.Errorf("%w: %s", ErrIndexExists, field)
+	}
+
+	if err := s.buildIndex(section, field, unique); err != nil {
+		return err
+	}
+	fid := s.fieldIdSFG(section, field)
+	s.store(indexKey(section, fid, unique), field)
+
+	s.sugar.Debugw("index created", "section", section, "field", field, "records", len(s.indexes[section][field].values))
+	return s.commit()
+}
+
+// DropIndex removes the index on the field of the section
+func (s *Stash) DropIndex(section SectionIdType, field string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx, ok := s.indexes[section][field]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrIndexNotFound, field)
+	}
+
+	s.unstore(indexKey(section, s.fieldIdSFG(section, field), idx.unique))
+	delete(s.indexes[section], field)
+
+	s.sugar.Debugw("index dropped", "section", section, "field", field)
+	return s.commit()
+}
+
+// ListIndexes returns indexes of the section sorted by the field name
+func (s *Stash) ListIndexes(section SectionIdType) []IndexInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	indexes := make([]IndexInfo, 0, len(s.indexes[section])+len(s.textIndexes[section]))
+	for field, idx := range s.indexes[section] {
+		indexes = append(indexes, IndexInfo{Field: field, Unique: idx.unique})
+	}
+	for field := range s.textIndexes[section] {
+		indexes = append(indexes, IndexInfo{Field: field, Text: true})
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		if indexes[i].Field != indexes[j].Field {
+			return indexes[i].Field < indexes[j].Field
+		}
+		return !indexes[i].Text
+	})
+	return indexes
+}
+
+// Lookup returns live records with the field equal to value using the index on the field,
+// value must be int, int64 or string
+func (s *Stash) Lookup(section SectionIdType, field string, value any) ([]Record, error) {
+	if _, ok := indexValue(value); !ok {
+		return nil, fmt.Errorf("%w: lookup by %T", errUnsupportedValue, value)
+	}
+	return s.LookupRange(section, field, value, value)
+}
+
+// LookupRange returns live records with the field value from..to inclusive using the index on the field,
+// nil bound is open. The records are sorted by the field value.
+func (s *Stash) LookupRange(section SectionIdType, field string, from, to any) ([]Record, error) {
+	bounds := []*any{&from, &to}
+	for _, bound := range bounds {
+		if *bound == nil {
+			continue
+		}
+		v, ok := indexValue(*bound)
+		if !ok {
+			return nil, fmt.Errorf("%w: lookup by %T", errUnsupportedValue, *bound)
+		}
+		*bound = v
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idx, ok := s.indexes[section][field]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrIndexNotFound, field)
+	}
+
+	guids := idx.scan(from, to)
+	records := make([]Record, 0, len(guids))
+	for _, guid := range guids {
+		key, err := s.liveKey(section, guid)
+		if errors.Is(err, ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var data map[string]any
+		if data, err = s.getData(key); err != nil {
+			return nil, err
+		}
+		records = append(records, Record{guid: guid, data: data})
+	}
+
+	s.sugar.Debugw("lookup", "section", section, "field", field, "from", from, "to", to, "records", len(records))
+	return records, nil
+}
+
+// indexPut updates indexes of the section with the new version of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) indexPut(section SectionIdType, guid GUIDType, data map[string]any) {
+	for field, idx := range s.indexes[section] {
+		idx.put(guid, data[field])
+	}
+	for field, idx := range s.textIndexes[section] {
+		idx.put(guid, data[field])
+	}
+}
+
+// indexDrop removes the record from indexes of the section
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) indexDrop(section SectionIdType, guid GUIDType) {
+	for _, idx := range s.indexes[section] {
+		idx.drop(guid)
+	}
+	for _, idx := range s.textIndexes[section] {
+		idx.drop(guid)
+	}
+}
+
+// buildIndex fills the index on the field with live records of the section
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) buildIndex(section SectionIdType, field string, unique bool) error {
+	idx := newIndex(unique)
+	for guid, key := range s.records[section] {
+		data, err := s.getData(key)
+		if err != nil {
+			return err
+		}
+		if holder, ok := s.uniqueHolder(section, idx, data[field], guid); ok && unique {
+			return ErrUniqueViolation{Field: field, Guid: holder}
+		}
+		idx.put(guid, data[field])
+	}
+
+	if s.indexes[section] == nil {
+		s.indexes[section] = make(map[string]*index)
+	}
+	s.indexes[section][field] = idx
+	return nil
+}
+
+// loadIndex restores the index definition from the system section, the index is built by buildIndexes
+func (s *Stash) loadIndex(key Key, value any) error {
+	unique := key.Record() >= uniqueIndexesRecordId
+	section := SectionIdType(key.Record() - indexesRecordId)
+	if unique {
+		section = SectionIdType(key.Record() - uniqueIndexesRecordId)
+	}
+	field, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("index field is not string (key %s)", key)
+	}
+
+	if s.indexes[section] == nil {
+		s.indexes[section] = make(map[string]*index)
+	}
+	s.indexes[section][field] = newIndex(unique)
+	return nil
+}
+
+// buildIndexes fills all declared indexes after the registries are rebuilt
+func (s *Stash) buildIndexes() error {
+	for section, indexes := range s.indexes {
+		for field, idx := range indexes {
+			if err := s.buildIndex(section, field, idx.unique); err != nil {
+				return err
+			}
+		}
+	}
+	for section, indexes := range s.textIndexes {
+		for field := range indexes {
+			if err := s.buildTextIndex(section, field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package stashdb
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func guidsOf(records []Record) []GUIDType {
+	guids := make([]GUIDType, 0, len(records))
+	for _, rec := range records {
+		guids = append(guids, rec.guid)
+	}
+	return guids
+}
+
+func Test_stash_Index(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	first, err := s.Insert(1, map[string]any{"tag": "#a", "n": int64(10)})
+	require.NoError(t, err)
+	second, err := s.Insert(1, map[string]any{"tag": "#b", "n": 20})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateIndex(1, "n"))
+	require.NoError(t, s.CreateIndex(1, "tag"))
+	require.ErrorIs(t, s.CreateIndex(1, "tag"), ErrIndexExists)
+	require.Equal(t, []IndexInfo{{Field: "n"}, {Field: "tag"}}, s.ListIndexes(1))
+
+	third, err := s.Insert(1, map[string]any{"tag": "#a", "n": int64(30)})
+	require.NoError(t, err)
+
+	records, err := s.Lookup(1, "tag", "#a")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{first, third}, guidsOf(records))
+
+	records, err = s.LookupRange(1, "n", 15, nil)
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{second, third}, guidsOf(records), "sorted by value")
+	require.EqualValues(t, 20, records[0].data["n"])
+
+	require.NoError(t, s.Update(1, second, map[string]any{"tag": "#a", "n": int64(5)}))
+	records, err = s.LookupRange(1, "n", nil, int64(10))
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{second, first}, guidsOf(records))
+
+	require.NoError(t, s.Remove(1, first))
+	records, err = s.Lookup(1, "tag", "#a")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{second, third}, guidsOf(records))
+
+	require.NoError(t, s.Restore(1, first))
+	records, err = s.Lookup(1, "n", 10)
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{first}, guidsOf(records))
+
+	_, err = s.Lookup(1, "n", 1.5)
+	require.ErrorIs(t, err, errUnsupportedValue)
+
+	require.NoError(t, s.DropIndex(1, "n"))
+	require.ErrorIs(t, s.DropIndex(1, "n"), ErrIndexNotFound)
+	_, err = s.Lookup(1, "n", 10)
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.Equal(t, []IndexInfo{{Field: "tag"}}, s.ListIndexes(1))
+}
+
+func Test_stash_Index_Restore(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateIndex(1, "tag"))
+	guid, err := s.Insert(1, map[string]any{"tag": "#a"})
+	require.NoError(t, err)
+	_, err = s.Insert(1, map[string]any{"tag": "#b"})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.Equal(t, []IndexInfo{{Field: "tag"}}, s.ListIndexes(1))
+	records, err := s.Lookup(1, "tag", "#a")
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{guid}, guidsOf(records))
+}
+
+func Test_index_putDrop(t *testing.T) {
+	idx := newIndex(false)
+	want := make(map[GUIDType]int64)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		guid := GUIDType(strconv.Itoa(rnd.Intn(1000)))
+		if rnd.Intn(4) == 0 {
+			idx.drop(guid)
+			delete(want, guid)
+			continue
+		}
+		v := int64(rnd.Intn(100))
+		idx.put(guid, v)
+		want[guid] = v
+	}
+	idx.put("text", "a")
+	idx.put("other", 1.5)
+
+	guids := make([]GUIDType, 0, len(want))
+	for guid := range want {
+		guids = append(guids, guid)
+	}
+	sort.Slice(guids, func(i, j int) bool {
+		if want[guids[i]] != want[guids[j]] {
+			return want[guids[i]] < want[guids[j]]
+		}
+		return guids[i] < guids[j]
+	})
+	require.Equal(t, append(guids, "text"), idx.scan(nil, nil), "sorted by value and guid, int64 before string")
+	require.Equal(t, guids, idx.scan(nil, int64(100)))
+	require.Equal(t, []GUIDType{"text"}, idx.holders("a"))
+	require.Contains(t, idx.other, GUIDType("other"))
+
+	var fifty []GUIDType
+	for _, guid := range guids {
+		if want[guid] == 50 {
+			fifty = append(fifty, guid)
+		}
+	}
+	require.Equal(t, fifty, idx.holders(50))
+	require.Equal(t, []GUIDType{"text"}, idx.scan(int64(1000), nil))
+	require.Empty(t, idx.holders("b"))
+}
@@ -1,9 +1,9 @@
 package stashdb
 
 // iterator holding the iterators state
-type iterator struct {
-	tree *redBlackTree
-	node *redBlackNode
+type iterator[K ordered[K]] struct {
+	tree *redBlackTree[K]
+	node *redBlackNode[K]
 	pos  position
 }
 
@@ -16,22 +16,22 @@ const (
 // iterator returns an iterator
 //
 // IMPORTANT: iterator does not provide thread safety
-func (t *redBlackTree) iterator() iterator {
-	return iterator{tree: t, node: nil, pos: begin}
+func (t *redBlackTree[K]) iterator() iterator[K] {
+	return iterator[K]{tree: t, node: nil, pos: begin}
 }
 
 // iteratorAt returns an iterator at node
 //
 // IMPORTANT: iterator does not provide thread safety
-func (t *redBlackTree) iteratorAt(node *redBlackNode) iterator {
+func (t *redBlackTree[K]) iteratorAt(node *redBlackNode[K]) iterator[K] {
 	if node == nil {
-		return iterator{tree: t, node: nil, pos: begin}
+		return iterator[K]{tree: t, node: nil, pos: begin}
 	}
-	return iterator{tree: t, node: node, pos: onmyway}
+	return iterator[K]{tree: t, node: node, pos: onmyway}
 }
 
 // next moves the iterator to the next element
-func (it *iterator) next() bool {
+func (it *iterator[K]) next() bool {
 	if it.pos == end {
 		it.node = nil
 		return false
@@ -73,7 +73,7 @@ func (it *iterator) next() bool {
 }
 
 // prev moves the iterator to the previous element
-func (it *iterator) prev() bool {
+func (it *iterator[K]) prev() bool {
 	if it.pos == begin {
 		it.node = nil
 		return false
@@ -115,20 +115,20 @@ func (it *iterator) prev() bool {
 }
 
 // begin resets the iterator to one-before-first
-func (it *iterator) begin() {
+func (it *iterator[K]) begin() {
 	it.node = nil
 	it.pos = begin
 }
 
 // end moves the iterator to one-past-the-end
-func (it *iterator) end() {
+func (it *iterator[K]) end() {
 	it.node = nil
 	it.pos = end
 }
 
 // min returns the minimal current or nil
-func (it *iterator) min() *redBlackNode {
-	var minNode *redBlackNode
+func (it *iterator[K]) min() *redBlackNode[K] {
+	var minNode *redBlackNode[K]
 	for curNode := it.tree.root; curNode != nil; curNode = curNode.left {
 		minNode = curNode
 	}
@@ -136,8 +136,8 @@ func (it *iterator) min() *redBlackNode {
 }
 
 // max returns the max current or nil
-func (it *iterator) max() *redBlackNode {
-	var maxNode *redBlackNode
+func (it *iterator[K]) max() *redBlackNode[K] {
+	var maxNode *redBlackNode[K]
 	for curNode := it.tree.root; curNode != nil; curNode = curNode.right {
 		maxNode = curNode
 	}
@@ -7,7 +7,7 @@ import (
 )
 
 func Test_iterator_next(t *testing.T) {
-	tree := newRedBlackTree()
+	tree := newRedBlackTree[Key]()
 	require.NotNil(t, tree)
 	require.EqualValues(t, tree.sizeof(), 0, "not empty")
 
@@ -0,0 +1,182 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// Query the search of records of the section
+type Query struct {
+	// Filter the filter expression, see Filter, empty - all records
+	Filter string
+	// Fields the projection, empty - all fields
+	Fields []string
+	// Limit the maximum number of records, 0 - unlimited
+	Limit int
+	// OrderBy the field to sort records by, records without it go last, empty - unordered
+	OrderBy string
+	Desc    bool
+}
+
+// Guid returns the record guid
+func (r Record) Guid() GUIDType {
+	return r.guid
+}
+
+// Data returns the record fields
+func (r Record) Data() map[string]any {
+	return r.data
+}
+
+// Query returns records of the section matching the query.
+// If the filter requires a condition on the indexed field, only records from the index range are checked.
+func (s *Stash) Query(ctx context.Context, section SectionIdType, q Query) ([]Record, error) {
+	filter, err := ParseFilter(q.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var founded []Record
+	err = s.match(ctx, section, filter, func(guid GUIDType, data map[string]any) bool {
+		founded = append(founded, Record{guid: guid, data: data})
+		return q.OrderBy != "" || q.Limit == 0 || len(founded) < q.Limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if q.OrderBy != "" {
+		sortRecords(founded, q.OrderBy, q.Desc)
+		if q.Limit > 0 && len(founded) > q.Limit {
+			founded = founded[:q.Limit]
+		}
+	}
+	if len(q.Fields) > 0 {
+		for i := range founded {
+			founded[i].data = project(founded[i].data, q.Fields)
+		}
+	}
+	return founded, nil
+}
+
+// match calls f for live records of the section matching the filter until f returns false
+func (s *Stash) match(ctx context.Context, section SectionIdType, filter *Filter, f func(GUIDType, map[string]any) bool) error {
+	guids, indexed := s.queryCandidates(section, filter.root)
+	s.sugar.Debugw("match", "section", section, "filter", filter, "candidates", len(guids), "indexed", indexed)
+
+	for _, guid := range guids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, err := s.Get(section, guid)
+		if errors.Is(err, ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !filter.Match(data) {
+			continue
+		}
+		if !f(guid, data) {
+			break
+		}
+	}
+	return nil
+}
+
+// queryCandidates returns guids of records which can match the filter,
+// true if they are taken from the index range
+func (s *Stash) queryCandidates(section SectionIdType, node filterNode) ([]GUIDType, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if idx, from, to, ok := s.indexRange(section, node); ok {
+		guids := idx.scan(from, to)
+		for guid := range idx.other {
+			guids = append(guids, guid)
+		}
+		return guids, true
+	}
+
+	guids := make([]GUIDType, 0, len(s.records[section]))
+	for guid := range s.records[section] {
+		guids = append(guids, guid)
+	}
+	return guids, false
+}
+
+// indexRange returns the index and its range which hold all records matching the filter node
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) indexRange(section SectionIdType, node filterNode) (*index, any, any, bool) {
+	switch n := node.(type) {
+	case andNode:
+		if idx, from, to, ok := s.indexRange(section, n.left); ok {
+			return idx, from, to, true
+		}
+		return s.indexRange(section, n.right)
+	case cmpNode:
+		idx, ok := s.indexes[section][n.field]
+		if !ok {
+			return nil, nil, nil, false
+		}
+		v, ok := indexValue(n.value)
+		if !ok {
+			return nil, nil, nil, false
+		}
+		// the bounds are inclusive, the filter drops the boundary values
+		switch n.op {
+		case "==":
+			return idx, v, v, true
+		case "<", "<=":
+			return idx, nil, v, true
+		case ">", ">=":
+			return idx, v, nil, true
+		}
+	}
+	return nil, nil, nil, false
+}
+
+// sortRecords sorts records by the field, records without the field go last
+func sortRecords(records []Record, field string, desc bool) {
+	sort.SliceStable(records, func(i, j int) bool {
+		a, aok := records[i].data[field]
+		b, bok := records[j].data[field]
+		if !aok || !bok {
+			return aok && !bok
+		}
+		c, ok := compareValues(a, b)
+		if !ok {
+			// numbers go before strings before others
+			return valueRank(a) < valueRank(b)
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func valueRank(v any) int {
+	switch v.(type) {
+	case int, int64, float64:
+		return 0
+	case string:
+		return 1
+	}
+	return 2
+}
+
+// project returns the record with the listed fields only
+func project(data map[string]any, fields []string) map[string]any {
+	res := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if value, ok := data[field]; ok {
+			res[field] = value
+		}
+	}
+	return res
+}
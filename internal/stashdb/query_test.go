@@ -0,0 +1,77 @@
+package stashdb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Query(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		_, err = s.Insert(1, map[string]any{
+			"tag":       fmt.Sprintf("#tag%d", i%3),
+			"int_value": int64(i),
+			"text":      fmt.Sprintf("sample text %d", i),
+		})
+		require.NoError(t, err)
+	}
+	_, err = s.Insert(1, map[string]any{"tag": "#tag0", "int_value": 7.5})
+	require.NoError(t, err)
+
+	values := func(records []Record) []any {
+		var res []any
+		for _, rec := range records {
+			res = append(res, rec.Data()["int_value"])
+		}
+		return res
+	}
+
+	query := Query{Filter: `tag == "#tag0" && int_value > 3`, OrderBy: "int_value"}
+	records, err := s.Query(ctx, 1, query)
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(6), 7.5, int64(9)}, values(records))
+
+	require.NoError(t, s.CreateIndex(1, "int_value"))
+	indexed, err := s.Query(ctx, 1, query)
+	require.NoError(t, err)
+	require.Equal(t, records, indexed, "the index gives the same result")
+
+	guids, isIndexed := s.queryCandidates(1, mustParseFilter(t, `int_value >= 8 && tag == "#tag0"`).root)
+	require.True(t, isIndexed)
+	require.Len(t, guids, 3, "two in the range and one float")
+
+	records, err = s.Query(ctx, 1, Query{
+		Filter:  `text ~ "sample" || tag == "#tag1"`,
+		Fields:  []string{"int_value"},
+		OrderBy: "int_value",
+		Desc:    true,
+		Limit:   3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []any{int64(9), int64(8), int64(7)}, values(records))
+	require.Equal(t, map[string]any{"int_value": int64(9)}, records[0].Data())
+
+	records, err = s.Query(ctx, 1, Query{Limit: 4})
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	_, err = s.Query(ctx, 1, Query{Filter: `tag ==`})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.Query(cancelled, 1, Query{})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func mustParseFilter(t *testing.T, src string) *Filter {
+	f, err := ParseFilter(src)
+	require.NoError(t, err)
+	return f
+}
@@ -0,0 +1,102 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Role the access level of the user to the section, every role includes the lower ones
+type Role byte
+
+const (
+	RoleNone Role = iota
+	// RoleReader reads records, history, indexes and the section config
+	RoleReader
+	// RoleWriter changes records
+	RoleWriter
+	// RoleAdmin manages the section config and indexes, on AllSections - users, grants and the compaction
+	RoleAdmin
+)
+
+// AllSections the grant on it applies to every section
+const AllSections SectionIdType = 0
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// String is Stringer implementation
+func (r Role) String() string {
+	switch r {
+	case RoleNone:
+		return "none"
+	case RoleReader:
+		return "reader"
+	case RoleWriter:
+		return "writer"
+	case RoleAdmin:
+		return "admin"
+	}
+	return "unknown"
+}
+
+// Grant sets the role of the user on the section, RoleNone revokes the grant
+func (s *Stash) Grant(name string, section SectionIdType, role Role) error {
+	if role > RoleAdmin {
+		return fmt.Errorf("%w: unknown role %d", ErrInvalidUser, role)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.getUser(name)
+	if err != nil {
+		return err
+	}
+	grants := make(map[SectionIdType]Role, len(u.grants)+1)
+	for sec, r := range u.grants {
+		grants[sec] = r
+	}
+	if role == RoleNone {
+		delete(grants, section)
+	} else {
+		grants[section] = role
+	}
+	u.grants = grants
+	s.store(userKey(s.users[name]), u)
+
+	s.sugar.Infow("grant", "user", name, "section", section, "role", role)
+	return s.commit()
+}
+
+// Grants returns roles of the user by section
+func (s *Stash) Grants(name string) (map[SectionIdType]Role, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, err := s.getUser(name)
+	if err != nil {
+		return nil, err
+	}
+	grants := make(map[SectionIdType]Role, len(u.grants))
+	for section, role := range u.grants {
+		grants[section] = role
+	}
+	return grants, nil
+}
+
+// Authorize checks that the user has at least the role on the section or on AllSections
+func (s *Stash) Authorize(name string, section SectionIdType, role Role) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, err := s.getUser(name)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPermissionDenied, err)
+	}
+	if u.grants[section] >= role || u.grants[AllSections] >= role {
+		return nil
+	}
+	if section == AllSections {
+		return fmt.Errorf("%w: %s needs %s role on all sections", ErrPermissionDenied, name, role)
+	}
+	return fmt.Errorf("%w: %s needs %s role on section %d", ErrPermissionDenied, name, role, section)
+}
@@ -0,0 +1,48 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Grant(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateUser("bob", "secret"))
+	require.NoError(t, s.CreateUser("root", "secret"))
+	require.ErrorIs(t, s.Grant("nobody", 5, RoleReader), ErrUserNotFound)
+
+	require.NoError(t, s.Grant("bob", 5, RoleReader))
+	require.NoError(t, s.Grant("bob", 7, RoleWriter))
+	require.NoError(t, s.Grant("root", AllSections, RoleAdmin))
+
+	require.NoError(t, s.Authorize("bob", 5, RoleReader))
+	require.ErrorIs(t, s.Authorize("bob", 5, RoleWriter), ErrPermissionDenied)
+	require.NoError(t, s.Authorize("bob", 7, RoleReader), "writer reads")
+	require.NoError(t, s.Authorize("bob", 7, RoleWriter))
+	require.ErrorIs(t, s.Authorize("bob", 7, RoleAdmin), ErrPermissionDenied)
+	require.ErrorIs(t, s.Authorize("bob", 8, RoleReader), ErrPermissionDenied)
+	require.ErrorIs(t, s.Authorize("bob", AllSections, RoleAdmin), ErrPermissionDenied)
+	require.NoError(t, s.Authorize("root", 8, RoleAdmin))
+	require.ErrorIs(t, s.Authorize("nobody", 5, RoleReader), ErrPermissionDenied)
+
+	require.NoError(t, s.SetPassword("bob", "changed"))
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	grants, err := s.Grants("bob")
+	require.NoError(t, err)
+	require.Equal(t, map[SectionIdType]Role{5: RoleReader, 7: RoleWriter}, grants, "grants are persisted and kept on password change")
+
+	require.NoError(t, s.Grant("bob", 7, RoleNone))
+	require.ErrorIs(t, s.Authorize("bob", 7, RoleReader), ErrPermissionDenied)
+	grants, err = s.Grants("bob")
+	require.NoError(t, err)
+	require.Equal(t, map[SectionIdType]Role{5: RoleReader}, grants)
+}
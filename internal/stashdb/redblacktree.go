@@ -10,30 +10,35 @@ const (
 	black, red color = true, false
 )
 
+// ordered the key of redBlackTree, Compare returns KeyLessThan, KeyEqual or KeyMoreThan
+type ordered[K any] interface {
+	Compare(other K) int
+}
+
 // redBlackTree main index
-type redBlackTree struct {
-	root *redBlackNode
+type redBlackTree[K ordered[K]] struct {
+	root *redBlackNode[K]
 	size int
 }
 
 // redBlackNode is a tree element
-type redBlackNode struct {
-	key    Key
+type redBlackNode[K ordered[K]] struct {
+	key    K
 	color  color
-	left   *redBlackNode
-	right  *redBlackNode
-	parent *redBlackNode
+	left   *redBlackNode[K]
+	right  *redBlackNode[K]
+	parent *redBlackNode[K]
 }
 
-func newRedBlackTree() *redBlackTree {
-	return &redBlackTree{}
+func newRedBlackTree[K ordered[K]]() *redBlackTree[K] {
+	return &redBlackTree[K]{}
 }
 
 // put inserts key into the tree.
 // thread safe
-func (t *redBlackTree) put(key Key) {
+func (t *redBlackTree[K]) put(key K) {
 	if t.root == nil {
-		t.root = &redBlackNode{key: key, color: black}
+		t.root = &redBlackNode[K]{key: key, color: black}
 		t.size++
 		return
 	}
@@ -45,7 +50,7 @@ func (t *redBlackTree) put(key Key) {
 			return
 		case KeyLessThan:
 			if curNode.left == nil {
-				curNode.left = &redBlackNode{key: key, color: red}
+				curNode.left = &redBlackNode[K]{key: key, color: red}
 				curNode.left.parent = curNode
 				t.insertCase1(curNode.left)
 				t.size++
@@ -54,7 +59,7 @@ func (t *redBlackTree) put(key Key) {
 			curNode = curNode.left
 		case KeyMoreThan:
 			if curNode.right == nil {
-				curNode.right = &redBlackNode{key: key, color: red}
+				curNode.right = &redBlackNode[K]{key: key, color: red}
 				curNode.right.parent = curNode
 				t.insertCase1(curNode.right)
 				t.size++
@@ -68,14 +73,32 @@ func (t *redBlackTree) put(key Key) {
 // get searches the node in the tree, nil not found
 //
 // thread safe
-func (t *redBlackTree) get(key Key) *redBlackNode {
+func (t *redBlackTree[K]) get(key K) *redBlackNode[K] {
 	return t.lookup(key)
 }
 
+// ceiling returns the node with the smallest key greater than or equal to key, nil not found
+func (t *redBlackTree[K]) ceiling(key K) *redBlackNode[K] {
+	var found *redBlackNode[K]
+	curNode := t.root
+	for curNode != nil {
+		switch key.Compare(curNode.key) {
+		case KeyEqual:
+			return curNode
+		case KeyLessThan:
+			found = curNode
+			curNode = curNode.left
+		case KeyMoreThan:
+			curNode = curNode.right
+		}
+	}
+	return found
+}
+
 // remove the node from the tree
 //
 // thread safe
-func (t *redBlackTree) remove(key Key) {
+func (t *redBlackTree[K]) remove(key K) {
 	delNode := t.lookup(key)
 	if delNode == nil {
 		return
@@ -87,7 +110,7 @@ func (t *redBlackTree) remove(key Key) {
 		delNode = replacementNode
 	}
 
-	var childNode *redBlackNode
+	var childNode *redBlackNode[K]
 	if delNode.left == nil || delNode.right == nil {
 		if delNode.right == nil {
 			childNode = delNode.left
@@ -107,15 +130,15 @@ func (t *redBlackTree) remove(key Key) {
 	t.size--
 }
 
-func (t *redBlackTree) sizeof() int {
+func (t *redBlackTree[K]) sizeof() int {
 	return t.size
 }
 
-func (n *redBlackNode) sizeof(tree *redBlackTree) int {
+func (n *redBlackNode[K]) sizeof(tree *redBlackTree[K]) int {
 	return n.size()
 }
 
-func (n *redBlackNode) size() int {
+func (n *redBlackNode[K]) size() int {
 	if n == nil {
 		return 0
 	}
@@ -129,7 +152,7 @@ func (n *redBlackNode) size() int {
 	return size
 }
 
-func (t *redBlackTree) String() string {
+func (t *redBlackTree[K]) String() string {
 	str := "redBlackTree\n"
 	if t.size != 0 {
 		output(t.root, "", true, &str)
@@ -137,7 +160,7 @@ func (t *redBlackTree) String() string {
 	return str
 }
 
-func (n *redBlackNode) String() string {
+func (n *redBlackNode[K]) String() string {
 	color := "B"
 	if nodeColor(n) == red {
 		color = "R"
@@ -145,7 +168,7 @@ func (n *redBlackNode) String() string {
 	return fmt.Sprintf("%s %v", color, n.key)
 }
 
-func output(node *redBlackNode, prefix string, tail bool, str *string) {
+func output[K ordered[K]](node *redBlackNode[K], prefix string, tail bool, str *string) {
 	if node.right != nil {
 		newPrefix := prefix
 		if tail {
@@ -175,7 +198,7 @@ func output(node *redBlackNode, prefix string, tail bool, str *string) {
 	}
 }
 
-func (t *redBlackTree) lookup(key Key) *redBlackNode {
+func (t *redBlackTree[K]) lookup(key K) *redBlackNode[K] {
 	curNode := t.root
 	for curNode != nil {
 		switch key.Compare(curNode.key) {
@@ -190,21 +213,21 @@ func (t *redBlackTree) lookup(key Key) *redBlackNode {
 	return nil
 }
 
-func (n *redBlackNode) grandparent() *redBlackNode {
+func (n *redBlackNode[K]) grandparent() *redBlackNode[K] {
 	if n != nil && n.parent != nil {
 		return n.parent.parent
 	}
 	return nil
 }
 
-func (n *redBlackNode) uncle() *redBlackNode {
+func (n *redBlackNode[K]) uncle() *redBlackNode[K] {
 	if n == nil || n.parent == nil || n.parent.parent == nil {
 		return nil
 	}
 	return n.parent.sibling()
 }
 
-func (n *redBlackNode) sibling() *redBlackNode {
+func (n *redBlackNode[K]) sibling() *redBlackNode[K] {
 	if n == nil || n.parent == nil {
 		return nil
 	}
@@ -214,7 +237,7 @@ func (n *redBlackNode) sibling() *redBlackNode {
 	return n.parent.left
 }
 
-func (t *redBlackTree) rotateLeft(node *redBlackNode) {
+func (t *redBlackTree[K]) rotateLeft(node *redBlackNode[K]) {
 	right := node.right
 	t.replaceNode(node, right)
 	node.right = right.left
@@ -225,7 +248,7 @@ func (t *redBlackTree) rotateLeft(node *redBlackNode) {
 	node.parent = right
 }
 
-func (t *redBlackTree) rotateRight(node *redBlackNode) {
+func (t *redBlackTree[K]) rotateRight(node *redBlackNode[K]) {
 	left := node.left
 	t.replaceNode(node, left)
 	node.left = left.right
@@ -236,7 +259,7 @@ func (t *redBlackTree) rotateRight(node *redBlackNode) {
 	node.parent = left
 }
 
-func (t *redBlackTree) replaceNode(old *redBlackNode, new *redBlackNode) {
+func (t *redBlackTree[K]) replaceNode(old *redBlackNode[K], new *redBlackNode[K]) {
 	if old.parent == nil {
 		t.root = new
 	} else {
@@ -251,7 +274,7 @@ func (t *redBlackTree) replaceNode(old *redBlackNode, new *redBlackNode) {
 	}
 }
 
-func (t *redBlackTree) insertCase1(node *redBlackNode) {
+func (t *redBlackTree[K]) insertCase1(node *redBlackNode[K]) {
 	if node.parent == nil {
 		node.color = black
 	} else {
@@ -259,14 +282,14 @@ func (t *redBlackTree) insertCase1(node *redBlackNode) {
 	}
 }
 
-func (t *redBlackTree) insertCase2(node *redBlackNode) {
+func (t *redBlackTree[K]) insertCase2(node *redBlackNode[K]) {
 	if nodeColor(node.parent) == black {
 		return
 	}
 	t.insertCase3(node)
 }
 
-func (t *redBlackTree) insertCase3(node *redBlackNode) {
+func (t *redBlackTree[K]) insertCase3(node *redBlackNode[K]) {
 	uncleNode := node.uncle()
 	if nodeColor(uncleNode) == red {
 		node.parent.color = black
@@ -278,7 +301,7 @@ func (t *redBlackTree) insertCase3(node *redBlackNode) {
 	}
 }
 
-func (t *redBlackTree) insertCase4(node *redBlackNode) {
+func (t *redBlackTree[K]) insertCase4(node *redBlackNode[K]) {
 	grandparentNode := node.grandparent()
 	if node == node.parent.right && node.parent == grandparentNode.left {
 		t.rotateLeft(node.parent)
@@ -290,7 +313,7 @@ func (t *redBlackTree) insertCase4(node *redBlackNode) {
 	t.insertCase5(node)
 }
 
-func (t *redBlackTree) insertCase5(node *redBlackNode) {
+func (t *redBlackTree[K]) insertCase5(node *redBlackNode[K]) {
 	node.parent.color = black
 	grandparentNode := node.grandparent()
 	grandparentNode.color = red
@@ -301,7 +324,7 @@ func (t *redBlackTree) insertCase5(node *redBlackNode) {
 	}
 }
 
-func (n *redBlackNode) maximumNode() *redBlackNode {
+func (n *redBlackNode[K]) maximumNode() *redBlackNode[K] {
 	if n == nil {
 		return nil
 	}
@@ -311,14 +334,14 @@ func (n *redBlackNode) maximumNode() *redBlackNode {
 	return n
 }
 
-func (t *redBlackTree) deleteCase1(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase1(node *redBlackNode[K]) {
 	if node.parent == nil {
 		return
 	}
 	t.deleteCase2(node)
 }
 
-func (t *redBlackTree) deleteCase2(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase2(node *redBlackNode[K]) {
 	siblingNode := node.sibling()
 	if nodeColor(siblingNode) == red {
 		node.parent.color = red
@@ -332,7 +355,7 @@ func (t *redBlackTree) deleteCase2(node *redBlackNode) {
 	t.deleteCase3(node)
 }
 
-func (t *redBlackTree) deleteCase3(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase3(node *redBlackNode[K]) {
 	siblingNode := node.sibling()
 	if nodeColor(node.parent) == black &&
 		nodeColor(siblingNode) == black &&
@@ -345,7 +368,7 @@ func (t *redBlackTree) deleteCase3(node *redBlackNode) {
 	}
 }
 
-func (t *redBlackTree) deleteCase4(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase4(node *redBlackNode[K]) {
 	siblingNode := node.sibling()
 	if nodeColor(node.parent) == red &&
 		nodeColor(siblingNode) == black &&
@@ -358,7 +381,7 @@ func (t *redBlackTree) deleteCase4(node *redBlackNode) {
 	}
 }
 
-func (t *redBlackTree) deleteCase5(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase5(node *redBlackNode[K]) {
 	siblingNode := node.sibling()
 	if node == node.parent.left &&
 		nodeColor(siblingNode) == black &&
@@ -378,7 +401,7 @@ func (t *redBlackTree) deleteCase5(node *redBlackNode) {
 	t.deleteCase6(node)
 }
 
-func (t *redBlackTree) deleteCase6(node *redBlackNode) {
+func (t *redBlackTree[K]) deleteCase6(node *redBlackNode[K]) {
 	siblingNode := node.sibling()
 	siblingNode.color = nodeColor(node.parent)
 	node.parent.color = black
@@ -391,7 +414,7 @@ func (t *redBlackTree) deleteCase6(node *redBlackNode) {
 	}
 }
 
-func nodeColor(node *redBlackNode) color {
+func nodeColor[K ordered[K]](node *redBlackNode[K]) color {
 	if node == nil {
 		return black
 	}
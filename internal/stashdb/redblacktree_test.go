@@ -28,7 +28,7 @@ func getTestLogger() *zap.Logger {
 }
 
 func Test_redBlackTree_Put(t1 *testing.T) {
-	tree := newRedBlackTree()
+	tree := newRedBlackTree[Key]()
 	require.NotNil(t1, tree)
 	require.EqualValues(t1, tree.sizeof(), 0, "not empty")
 
@@ -62,7 +62,7 @@ func Test_redBlackTree_Put(t1 *testing.T) {
 }
 
 func TestRedBlackTree_Remove(t1 *testing.T) {
-	tree := newRedBlackTree()
+	tree := newRedBlackTree[Key]()
 	require.NotNil(t1, tree)
 	require.EqualValues(t1, tree.sizeof(), 0, "not empty")
 
@@ -118,3 +118,17 @@ func TestRedBlackTree_Remove(t1 *testing.T) {
 	require.EqualValues(t1, 3, tree.get(NewKey(0, 2, 0)).sizeof(tree), "wrong size`")
 	require.EqualValues(t1, 2, tree.get(NewKey(0, 6, 0)).sizeof(tree), "wrong size`")
 }
+
+func Test_redBlackTree_Ceiling(t1 *testing.T) {
+	tree := newRedBlackTree[Key]()
+	require.Nil(t1, tree.ceiling(NewKey(0, 1, 0)))
+
+	for _, rec := range []RecordIdType{2, 4, 6, 8} {
+		tree.put(NewKey(1, rec, 0))
+	}
+
+	require.Equal(t1, NewKey(1, 2, 0), tree.ceiling(NewKey(0, 9, 0)).key)
+	require.Equal(t1, NewKey(1, 4, 0), tree.ceiling(NewKey(1, 4, 0)).key)
+	require.Equal(t1, NewKey(1, 6, 0), tree.ceiling(NewKey(1, 4, 1)).key)
+	require.Nil(t1, tree.ceiling(NewKey(1, 8, 1)))
+}
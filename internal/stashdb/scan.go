@@ -0,0 +1,44 @@
+package stashdb
+
+import "time"
+
+// ScanBatch returns up to limit live records of the section in key order starting after the record id after,
+// and the record id to pass as after to continue, less than limit records means the end of the section.
+// The lock is held for the batch only, so the scan of the whole section doesn't block writers.
+// Records inserted after the cursor are returned by later batches. A record updated after the cursor
+// passed it is returned again as its new version has the greater id, so no live record is missed,
+// but the caller must deduplicate records by guid keeping the last returned copy, it is the newest one.
+func (s *Stash) ScanBatch(section SectionIdType, after RecordIdType, limit int) ([]Record, RecordIdType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []Record
+	it := s.iteratorAt(s.ceiling(NewKey(section, after+1, headerFieldId)))
+	for ; it.pos == onmyway && len(records) < limit; it.next() {
+		key := it.node.key
+		if key.Section() != section {
+			break
+		}
+		if key.Field() != headerFieldId {
+			continue
+		}
+		after = key.Record()
+
+		header, err := s.getRecordHeader(key)
+		if err != nil {
+			return nil, after, err
+		}
+		if header.deleted || header.expired(time.Now()) {
+			continue
+		}
+
+		var data map[string]any
+		if data, err = s.getData(key); err != nil {
+			return nil, after, err
+		}
+		records = append(records, Record{guid: header.guid, data: data})
+	}
+
+	s.sugar.Debugw("scan batch", "section", section, "records", len(records), "after", after)
+	return records, after, nil
+}
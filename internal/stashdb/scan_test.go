@@ -0,0 +1,40 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_ScanBatch(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	var guids []GUIDType
+	for i := 0; i < 5; i++ {
+		guid, err := s.Insert(1, map[string]any{"n": int64(i)})
+		require.NoError(t, err)
+		guids = append(guids, guid)
+	}
+	_, err = s.Insert(2, map[string]any{"n": int64(100)})
+	require.NoError(t, err)
+	require.NoError(t, s.Remove(1, guids[1]))
+
+	records, after, err := s.ScanBatch(1, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{guids[0], guids[2]}, guidsOf(records), "removed records are skipped")
+
+	inserted, err := s.Insert(1, map[string]any{"n": int64(5)})
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guids[0], map[string]any{"n": int64(10)}))
+
+	records, after, err = s.ScanBatch(1, after, 10)
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{guids[3], guids[4], inserted, guids[0]}, guidsOf(records),
+		"resumed after new inserts, updated record comes again")
+	require.EqualValues(t, 10, records[3].data["n"])
+
+	records, _, err = s.ScanBatch(1, after, 10)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
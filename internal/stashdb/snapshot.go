@@ -0,0 +1,321 @@
+package stashdb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	snapshotExt   = ".snap"
+	snapshotMagic = "OSSNAP01"
+	// snapshotBatch the number of keys copied under one lock
+	snapshotBatch = 4096
+)
+
+var (
+	ErrNoDataDir         = errors.New("data dir is not set")
+	ErrSnapshotCorrupted = errors.New("snapshot corrupted")
+)
+
+// snapshotEntry the key-value pair of the snapshot
+type snapshotEntry struct {
+	key   Key
+	value any
+}
+
+func snapshotName(lsn uint64) string {
+	return fmt.Sprintf("%016x%s", lsn, snapshotExt)
+}
+
+// snapshots returns the snapshot files sorted from the newest to the oldest
+func snapshots(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != snapshotExt {
+			continue
+		}
+		if _, err := strconv.ParseUint(strings.TrimSuffix(e.Name(), snapshotExt), 16, 64); err != nil {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names, nil
+}
+
+// WriteSnapshot writes the consistent copy of the whole keyspace (record headers, field names and
+// section counters) to the data dir and removes the write-ahead log segments covered by it.
+//
+// Writers are blocked only while the log is rotated and while a batch of keys is copied in memory,
+// the batch is written to the file before the next one is copied.
+// Keys written after the rotation may be copied or not, the replay of the log from the rotation
+// makes the loaded copy consistent as frames hold the new values of keys, not the changes.
+func (s *Stash) WriteSnapshot() error {
+	s.snapMu.Lock()
+	defer s.snapMu.Unlock()
+
+	s.mu.Lock()
+	if s.wal == nil {
+		s.mu.Unlock()
+		return ErrNoDataDir
+	}
+	w := s.wal
+	lsn, err := w.rotate()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	started := time.Now()
+	var batch []snapshotEntry
+	var last *Key
+	keys, err := writeSnapshot(w.dir, lsn, func() ([]snapshotEntry, error) {
+		var err error
+		if batch, err = s.dumpBatch(batch[:0], last); err != nil || len(batch) == 0 {
+			return nil, err
+		}
+		key := batch[len(batch)-1].key
+		last = &key
+		return batch, nil
+	})
+	if err != nil {
+		return err
+	}
+	s.sugar.Infow("snapshot written", "lsn", lsn, "keys", keys, "duration", time.Since(started))
+
+	if err = w.truncate(lsn); err != nil {
+		return err
+	}
+
+	var names []string
+	names, err = snapshots(w.dir)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if name == snapshotName(lsn) {
+			continue
+		}
+		if err = os.Remove(filepath.Join(w.dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpBatch appends up to snapshotBatch key-value pairs following the key after, nil - from the first key.
+// The lock is held for the batch only.
+func (s *Stash) dumpBatch(entries []snapshotEntry, after *Key) ([]snapshotEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	it := s.iterator()
+	if after != nil {
+		it = s.iteratorAt(s.ceiling(*after))
+		if it.pos != onmyway {
+			return entries, nil
+		}
+		if it.node.key == *after {
+			it.next()
+		}
+	} else {
+		it.next()
+	}
+
+	for n := 0; it.pos == onmyway && n < snapshotBatch; it.next() {
+		value, ok := s.m.Load(it.node.key)
+		if !ok {
+			return nil, fmt.Errorf("dump: key %s has no value", it.node.key)
+		}
+		if counter, ok := value.(*uint64); ok {
+			c := atomic.LoadUint64(counter)
+			value = &c
+		}
+		entries = append(entries, snapshotEntry{key: it.node.key, value: value})
+		n++
+	}
+	return entries, nil
+}
+
+// writeSnapshot writes batches of entries returned by next to the temporary file until next returns
+// no entries and renames the file when it is synced, returns the number of written entries
+//
+// format: magic, lsn uint64, entries (key, value), entries count uint64, crc32 of all above
+func writeSnapshot(dir string, lsn uint64, next func() ([]snapshotEntry, error)) (int, error) {
+	tmp, err := os.CreateTemp(dir, "snapshot-*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	crc := crc32.NewIEEE()
+	bw := bufio.NewWriter(io.MultiWriter(tmp, crc))
+
+	var buf bytes.Buffer
+	buf.WriteString(snapshotMagic)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], lsn)
+	buf.Write(b[:])
+	if _, err = bw.Write(buf.Bytes()); err != nil {
+		return 0, err
+	}
+	buf.Reset()
+
+	var count int
+	for {
+		var entries []snapshotEntry
+		if entries, err = next(); err != nil {
+			return 0, err
+		}
+		if len(entries) == 0 {
+			break
+		}
+		for _, e := range entries {
+			buf.Write(e.key[:])
+			if err = encodeValue(&buf, e.value); err != nil {
+				return 0, fmt.Errorf("key %s: %w", e.key, err)
+			}
+			if _, err = bw.Write(buf.Bytes()); err != nil {
+				return 0, err
+			}
+			buf.Reset()
+		}
+		count += len(entries)
+	}
+
+	binary.BigEndian.PutUint64(b[:], uint64(count))
+	if _, err = bw.Write(b[:]); err != nil {
+		return 0, err
+	}
+	if err = bw.Flush(); err != nil {
+		return 0, err
+	}
+
+	binary.BigEndian.PutUint32(b[:4], crc.Sum32())
+	if _, err = tmp.Write(b[:4]); err != nil {
+		return 0, err
+	}
+	if err = tmp.Sync(); err != nil {
+		return 0, err
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, err
+	}
+	if err = os.Rename(tmp.Name(), filepath.Join(dir, snapshotName(lsn))); err != nil {
+		return 0, err
+	}
+	return count, syncDir(dir)
+}
+
+// readSnapshot reads and verifies the snapshot file
+func readSnapshot(path string) (uint64, []snapshotEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(data) < len(snapshotMagic)+8+8+4 || string(data[:len(snapshotMagic)]) != snapshotMagic {
+		return 0, nil, ErrSnapshotCorrupted
+	}
+	body, sum := data[:len(data)-4], binary.BigEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return 0, nil, ErrSnapshotCorrupted
+	}
+	n := binary.BigEndian.Uint64(body[len(body)-8:])
+
+	r := bytes.NewReader(body[len(snapshotMagic) : len(body)-8])
+	var b [8]byte
+	if _, err = io.ReadFull(r, b[:]); err != nil {
+		return 0, nil, err
+	}
+	lsn := binary.BigEndian.Uint64(b[:])
+
+	entries := make([]snapshotEntry, 0, n)
+	for i := uint64(0); i < n; i++ {
+		var e snapshotEntry
+		if _, err = io.ReadFull(r, e.key[:]); err != nil {
+			return 0, nil, err
+		}
+		if e.value, err = decodeValue(r); err != nil {
+			return 0, nil, fmt.Errorf("%w: %v", ErrSnapshotCorrupted, err)
+		}
+		entries = append(entries, e)
+	}
+	if r.Len() != 0 {
+		return 0, nil, fmt.Errorf("%w: %d bytes after %d entries", ErrSnapshotCorrupted, r.Len(), n)
+	}
+	return lsn, entries, nil
+}
+
+// loadSnapshot applies the newest snapshot, returns its lsn (0 - no snapshot).
+// Older snapshots are not used as a fallback because the log they need may be already truncated.
+func (s *Stash) loadSnapshot(dir string) (uint64, error) {
+	tmps, _ := filepath.Glob(filepath.Join(dir, "snapshot-*.tmp"))
+	for _, tmp := range tmps {
+		_ = os.Remove(tmp)
+	}
+
+	names, err := snapshots(dir)
+	if err != nil || len(names) == 0 {
+		return 0, err
+	}
+
+	lsn, entries, err := readSnapshot(filepath.Join(dir, names[0]))
+	if err != nil {
+		return 0, fmt.Errorf("snapshot %s: %w", names[0], err)
+	}
+	for _, e := range entries {
+		s.apply(walMutation{key: e.key, value: e.value})
+	}
+	s.sugar.Infow("snapshot loaded", "snapshot", names[0], "keys", len(entries))
+	return lsn, nil
+}
+
+// snapshotLoop writes snapshots every interval until the Stash is closed
+func (s *Stash) snapshotLoop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.WriteSnapshot(); err != nil && !errors.Is(err, ErrNoDataDir) {
+				s.sugar.Errorw("snapshot", "err", err)
+			}
+		}
+	}
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
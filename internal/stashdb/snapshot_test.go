@@ -0,0 +1,174 @@
+package stashdb
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_WriteSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	guids := make([]GUIDType, 0, 10)
+	for i := 0; i < 10; i++ {
+		guid, err := s.Insert(1, map[string]any{
+			"tag":                       "#tag" + strconv.Itoa(i),
+			"int_val" + strconv.Itoa(i): int64(i),
+		})
+		require.NoError(t, err)
+		guids = append(guids, guid)
+	}
+	require.NoError(t, s.Update(1, guids[0], map[string]any{"tag": "#updated"}))
+	require.NoError(t, s.Remove(1, guids[1]))
+
+	require.NoError(t, s.WriteSnapshot())
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+walExt))
+	require.NoError(t, err)
+	require.Len(t, segments, 1, "covered segments must be truncated")
+
+	require.NoError(t, s.Update(1, guids[2], map[string]any{"tag": "#after snapshot"}))
+	require.NoError(t, s.Remove(1, guids[3]))
+	guid, err := s.Insert(2, map[string]any{"tag": "#tag"})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	from, err := s.Get(1, guids[0])
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#updated"}, from)
+
+	_, err = s.Get(1, guids[1])
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	from, err = s.Get(1, guids[2])
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#after snapshot"}, from)
+
+	_, err = s.Get(1, guids[3])
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	from, err = s.Get(1, guids[9])
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#tag9", "int_val9": int64(9)}, from)
+
+	from, err = s.Get(2, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#tag"}, from)
+
+	guid, err = s.Insert(1, map[string]any{"tag": "#new"})
+	require.NoError(t, err)
+	key, err := s.recordKeySFG(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, 13, key.Record(), "counter must survive restart")
+}
+
+func Test_stash_WriteSnapshotInMemory(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.ErrorIs(t, s.WriteSnapshot(), ErrNoDataDir)
+}
+
+func Test_stash_WriteSnapshotConcurrent(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	guids := make([]GUIDType, 0, snapshotBatch)
+	for i := 0; i < snapshotBatch; i++ {
+		guid, err := s.Insert(1, map[string]any{"n": int64(i)})
+		require.NoError(t, err)
+		guids = append(guids, guid)
+	}
+
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 100; i++ {
+			if err := s.Update(1, guids[i], map[string]any{"n": int64(-i)}); err != nil {
+				done <- err
+				return
+			}
+			if err := s.Remove(1, guids[len(guids)-1-i]); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+	require.NoError(t, s.WriteSnapshot(), "the keyspace changes while it is copied")
+	require.NoError(t, <-done)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	for i, guid := range guids {
+		from, err := s.Get(1, guid)
+		switch {
+		case i < 100:
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"n": int64(-i)}, from)
+		case i >= len(guids)-100:
+			require.ErrorIs(t, err, ErrRecordNotFound)
+		default:
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"n": int64(i)}, from)
+		}
+	}
+}
+
+func Test_snapshot_batches(t *testing.T) {
+	dir := t.TempDir()
+
+	var want []snapshotEntry
+	batches := make([][]snapshotEntry, 3)
+	for i := range batches {
+		for j := 0; j < 10; j++ {
+			e := snapshotEntry{key: NewKey(1, RecordIdType(i*10+j+1), 0), value: int64(i*10 + j)}
+			batches[i] = append(batches[i], e)
+			want = append(want, e)
+		}
+	}
+	next := 0
+	n, err := writeSnapshot(dir, 7, func() ([]snapshotEntry, error) {
+		if next == len(batches) {
+			return nil, nil
+		}
+		next++
+		return batches[next-1], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(want), n)
+
+	path := filepath.Join(dir, snapshotName(7))
+	lsn, entries, err := readSnapshot(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, lsn)
+	require.Equal(t, want, entries)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-1], 0o644))
+	_, _, err = readSnapshot(path)
+	require.ErrorIs(t, err, ErrSnapshotCorrupted, "the trailer is cut off")
+
+	_, err = writeSnapshot(dir, 8, func() ([]snapshotEntry, error) {
+		return nil, errors.New("dump failed")
+	})
+	require.Error(t, err)
+	tmps, err := filepath.Glob(filepath.Join(dir, "*.tmp"))
+	require.NoError(t, err)
+	require.Empty(t, tmps, "the temporary file is removed on failure")
+}
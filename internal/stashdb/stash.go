@@ -18,35 +18,61 @@ type OperationType string
 type GUIDType string
 
 const (
-	//	metadataSection  SectionIdType = 0
-	//  usersRecord RecordIdType = 1
+	metadataSection SectionIdType = 0
+	// usersRecordId the record of the system section with users, the field id is the user id
+	usersRecordId RecordIdType = 1
+	// seqRecordId the record of the system section with the sequence number of the last commit
+	seqRecordId RecordIdType = 2
 
 	metadataRecordId RecordIdType = 0
 	counterFieldId   FieldIdType  = 0
 	headerFieldId    FieldIdType  = 0
 
-	InsertOperation OperationType = "insert"
-	UpdateOperation OperationType = "update"
+	InsertOperation  OperationType = "insert"
+	UpdateOperation  OperationType = "update"
+	RevertOperation  OperationType = "revert"
+	RestoreOperation OperationType = "restore"
+	RemoveOperation  OperationType = "remove"
 )
 
 var (
-	ErrRecordNotFound = errors.New("record not found")
-	ErrFieldNotFound  = errors.New("field not found")
-	ErrNotImplemented = errors.New("not implemented")
+	ErrRecordNotFound  = errors.New("record not found")
+	ErrFieldNotFound   = errors.New("field not found")
+	ErrVersionNotFound = errors.New("version not found")
+	ErrRecordIsAlive   = errors.New("record is not removed")
+	ErrNotImplemented  = errors.New("not implemented")
+	ErrClosed          = errors.New("stash is closed")
 )
 
 type recordHeader struct {
 	guid      GUIDType
 	next      RecordIdType
+	prev      RecordIdType
+	revision  uint64
 	operation OperationType
-	// user      string
+	// user the author of the version, empty - the system
+	user    string
 	time    time.Time
+	removed time.Time
 	deleted bool
+	// removedBy the user who removed the record
+	removedBy string
+	// seq the sequence number of the commit which wrote the version
+	seq uint64
+	// removedSeq the sequence number of the commit which removed the record, 0 - not removed
+	removedSeq uint64
+	// expires the version is invisible from this time on, zero - never
+	expires time.Time
+}
+
+func newGUID() GUIDType {
+	return GUIDType(uuid.New().String())
 }
 
 func newRecordHeader(op OperationType) recordHeader {
 	return recordHeader{
-		guid:      GUIDType(uuid.New().String()),
+		guid:      newGUID(),
+		revision:  1,
 		time:      time.Now(),
 		deleted:   false,
 		operation: op,
@@ -61,7 +87,7 @@ type Record struct {
 // Stash the in-memory NoSQL key-value tread safe stashdb.
 // Most important part - synthetic key (see Key type)
 type Stash struct {
-	redBlackTree
+	redBlackTree[Key]
 	m  sync.Map
 	mu sync.RWMutex
 
@@ -73,16 +99,391 @@ type Stash struct {
 	recordsSFG singleflight.Group
 	recordsMu  sync.Mutex
 
+	// tombstones holds the last version of removed records, guarded by mu
+	tombstones map[SectionIdType]map[GUIDType]Key
+	// sections holds the settings of sections, guarded by mu
+	sections map[SectionIdType]SectionConfig
+	// indexes holds secondary indexes by section and field name, guarded by mu
+	indexes map[SectionIdType]map[string]*index
+	// textIndexes holds full-text indexes by section and field name, guarded by mu
+	textIndexes map[SectionIdType]map[string]*textIndex
+	// users holds user ids by name, guarded by mu
+	users map[string]FieldIdType
+
+	sessions   map[string]session
+	sessionTTL time.Duration
+	sessionsMu sync.Mutex
+
+	wal     *wal
+	pending []walMutation
+	// undo holds previous values of keys changed by the current operation, see rollback
+	undo   []undoEntry
+	snapMu sync.Mutex
+	// seq the sequence number of the last commit, guarded by mu
+	seq uint64
+	// events holds changes of the current operation, they are published to feed by commit
+	events []Event
+	feed   *feed
+
+	metrics   CompactMetrics
+	metricsMu sync.Mutex
+
+	// views holds the open read views, see Snapshot
+	views   map[*View]struct{}
+	viewsMu sync.Mutex
+
+	done chan struct{}
+	wg   sync.WaitGroup
+
 	sugar *zap.SugaredLogger
 }
 
-func NewStash(logger *zap.Logger) *Stash {
-	return &Stash{
-		redBlackTree: redBlackTree{},
+type options struct {
+	dir              string
+	syncPolicy       SyncPolicy
+	syncInterval     time.Duration
+	snapshotInterval time.Duration
+	compactInterval  time.Duration
+	reapInterval     time.Duration
+	sessionTTL       time.Duration
+}
+
+// Option configures the Stash
+type Option func(*options)
+
+// WithDataDir enables the write-ahead log in dir, without it the Stash lives in memory only
+func WithDataDir(dir string) Option {
+	return func(o *options) {
+		o.dir = dir
+	}
+}
+
+// WithSyncPolicy sets when the write-ahead log is flushed to the disk,
+// interval is used by SyncInterval only
+func WithSyncPolicy(policy SyncPolicy, interval time.Duration) Option {
+	return func(o *options) {
+		o.syncPolicy = policy
+		o.syncInterval = interval
+	}
+}
+
+// WithSnapshotInterval enables writing snapshots in background every interval
+func WithSnapshotInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.snapshotInterval = interval
+	}
+}
+
+// WithCompactInterval enables the background compaction every interval
+func WithCompactInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.compactInterval = interval
+	}
+}
+
+// WithReapInterval enables removing expired records in background every interval, see WithTTL
+func WithReapInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.reapInterval = interval
+	}
+}
+
+// WithSessionTTL sets how long the token issued by Login is valid, defaultSessionTTL if not set
+func WithSessionTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.sessionTTL = ttl
+	}
+}
+
+// NewStash creates the Stash and restores its state from the newest snapshot and the write-ahead log
+// if the data dir is set
+func NewStash(logger *zap.Logger, opts ...Option) (*Stash, error) {
+	o := options{sessionTTL: defaultSessionTTL}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s := &Stash{
+		redBlackTree: redBlackTree[Key]{},
 		sugar:        logger.Sugar(),
 		fields:       make(map[SectionIdType]map[string]FieldIdType, 0),
 		records:      make(map[SectionIdType]map[GUIDType]Key, 0),
+		tombstones:   make(map[SectionIdType]map[GUIDType]Key, 0),
+		sections:     make(map[SectionIdType]SectionConfig, 0),
+		indexes:      make(map[SectionIdType]map[string]*index, 0),
+		textIndexes:  make(map[SectionIdType]map[string]*textIndex, 0),
+		users:        make(map[string]FieldIdType),
+		sessions:     make(map[string]session),
+		sessionTTL:   o.sessionTTL,
+		views:        make(map[*View]struct{}),
+		feed:         newFeed(),
+		done:         make(chan struct{}),
+	}
+
+	if o.dir != "" {
+		if err := s.restore(o); err != nil {
+			return nil, err
+		}
+	}
+	s.feed.reset(s.seq)
+
+	if o.snapshotInterval > 0 && s.wal != nil {
+		s.wg.Add(1)
+		go s.snapshotLoop(o.snapshotInterval)
 	}
+	if o.compactInterval > 0 {
+		s.wg.Add(1)
+		go s.compactLoop(o.compactInterval)
+	}
+	if o.reapInterval > 0 {
+		s.wg.Add(1)
+		go s.reapLoop(o.reapInterval)
+	}
+
+	return s, nil
+}
+
+// restore loads the newest snapshot and replays the write-ahead log
+func (s *Stash) restore(o options) error {
+	var err error
+	s.wal, err = openWAL(o.dir, o.syncPolicy, o.syncInterval, s.sugar)
+	if err != nil {
+		return err
+	}
+	var lsn uint64
+	if lsn, err = s.loadSnapshot(o.dir); err != nil {
+		return err
+	}
+	s.wal.start, s.wal.lsn = lsn, lsn
+	if err = s.wal.replay(s.apply); err != nil {
+		return err
+	}
+	if err = s.rebuild(); err != nil {
+		_ = s.wal.close()
+		return err
+	}
+	s.sugar.Infow("stash restored", "dir", o.dir, "keys", s.sizeof(), "lsn", s.wal.lsn)
+	return nil
+}
+
+// Close stops background jobs and watchers, flushes and closes the write-ahead log
+func (s *Stash) Close() error {
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.wal == nil {
+		return nil
+	}
+	err := s.wal.close()
+	s.wal = nil
+	return err
+}
+
+// store saves value and puts key into the tree, the change is logged by commit
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) store(key Key, value any) {
+	s.saveUndo(key)
+	s.m.Store(key, value)
+	s.put(key)
+	s.pending = append(s.pending, walMutation{key: key, value: value})
+}
+
+// unstore removes key from the tree, the change is logged by commit
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) unstore(key Key) {
+	s.saveUndo(key)
+	s.m.Delete(key)
+	s.remove(key)
+	s.pending = append(s.pending, walMutation{key: key, removed: true})
+}
+
+// undoEntry the value of the key before the change
+type undoEntry struct {
+	key     Key
+	value   any
+	existed bool
+}
+
+// saveUndo remembers the value of the key before the change for rollback
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) saveUndo(key Key) {
+	value, ok := s.m.Load(key)
+	s.undo = append(s.undo, undoEntry{key: key, value: value, existed: ok})
+}
+
+// commit writes the changes made by the current operation to the write-ahead log, then makes them
+// visible to the read views opened after it and publishes them to watchers. If the log rejects
+// the changes, they are rolled back.
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) commit() error {
+	muts, undo, events := s.pending, s.undo, s.events
+	s.pending, s.undo, s.events = nil, nil, nil
+	if len(muts) == 0 {
+		return nil
+	}
+
+	seq := s.seq + 1
+	seqKey := NewKey(metadataSection, seqRecordId, 0)
+	muts = append(muts, walMutation{key: seqKey, value: int64(seq)})
+	if s.wal != nil {
+		if err := s.wal.append(muts); err != nil {
+			s.sugar.Errorw("wal append", "err", err)
+			if rerr := s.rollback(undo); rerr != nil {
+				s.sugar.Errorw("rollback", "err", rerr)
+			}
+			return err
+		}
+	}
+
+	s.seq = seq
+	s.m.Store(seqKey, int64(seq))
+	s.put(seqKey)
+	for i := range events {
+		events[i].Seq = seq
+	}
+	s.feed.publish(seq, events)
+	return nil
+}
+
+// abort discards the changes of the current operation
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) abort() error {
+	undo := s.undo
+	s.pending, s.undo, s.events = nil, nil, nil
+	return s.rollback(undo)
+}
+
+// rollback restores keys changed by the operation rejected by the write-ahead log or aborted and rebuilds
+// registries and indexes from the restored keys. Record counters are not restored, ids are skipped.
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) rollback(undo []undoEntry) error {
+	for i := len(undo) - 1; i >= 0; i-- {
+		u := undo[i]
+		if u.existed {
+			s.m.Store(u.key, u.value)
+			s.put(u.key)
+		} else {
+			s.m.Delete(u.key)
+			s.remove(u.key)
+		}
+	}
+
+	s.fieldsMu.Lock()
+	s.fields = make(map[SectionIdType]map[string]FieldIdType, len(s.fields))
+	s.fieldsMu.Unlock()
+	s.recordsMu.Lock()
+	s.records = make(map[SectionIdType]map[GUIDType]Key, len(s.records))
+	s.recordsMu.Unlock()
+	s.tombstones = make(map[SectionIdType]map[GUIDType]Key, len(s.tombstones))
+	s.sections = make(map[SectionIdType]SectionConfig, len(s.sections))
+	s.indexes = make(map[SectionIdType]map[string]*index, len(s.indexes))
+	s.textIndexes = make(map[SectionIdType]map[string]*textIndex, len(s.textIndexes))
+	s.users = make(map[string]FieldIdType, len(s.users))
+
+	s.sugar.Warnw("rollback", "keys", len(undo))
+	return s.rebuild()
+}
+
+// nextSeq returns the sequence number of the commit in progress
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) nextSeq() uint64 {
+	return s.seq + 1
+}
+
+// apply the mutation read from the write-ahead log
+func (s *Stash) apply(m walMutation) {
+	if m.removed {
+		s.m.Delete(m.key)
+		s.remove(m.key)
+		return
+	}
+	s.m.Store(m.key, m.value)
+	s.put(m.key)
+}
+
+// rebuild restores fields and records registries from the tree
+func (s *Stash) rebuild() error {
+	it := s.iterator()
+	for it.next() {
+		key := it.node.key
+		value, ok := s.m.Load(key)
+		if !ok {
+			return fmt.Errorf("rebuild: key %s has no value", key)
+		}
+
+		section := key.Section()
+		switch {
+		case key.Record() == metadataRecordId && key.Field() != counterFieldId:
+			name, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("rebuild: field name is not string (key %s)", key)
+			}
+			if s.fields[section] == nil {
+				s.fields[section] = make(map[string]FieldIdType)
+			}
+			s.fields[section][name] = key.Field()
+		case section == metadataSection && key.Record() == usersRecordId:
+			if err := s.loadUser(key, value); err != nil {
+				return fmt.Errorf("rebuild: %w", err)
+			}
+		case section == metadataSection && key.Record() == seqRecordId:
+			seq, ok := value.(int64)
+			if !ok {
+				return fmt.Errorf("rebuild: sequence number is not int64 (key %s)", key)
+			}
+			if uint64(seq) > s.seq {
+				s.seq = uint64(seq)
+			}
+		case section == metadataSection && key.Record() >= sectionsRecordId && key.Record() <= sectionsRecordId+0xff:
+			if err := s.loadSectionConfig(key, value); err != nil {
+				return fmt.Errorf("rebuild: %w", err)
+			}
+		case section == metadataSection && key.Record() >= indexesRecordId && key.Record() <= uniqueIndexesRecordId+0xff:
+			if err := s.loadIndex(key, value); err != nil {
+				return fmt.Errorf("rebuild: %w", err)
+			}
+		case section == metadataSection && key.Record() >= textIndexesRecordId && key.Record() <= textIndexesRecordId+0xff:
+			if err := s.loadTextIndex(key, value); err != nil {
+				return fmt.Errorf("rebuild: %w", err)
+			}
+		case section != metadataSection && key.Record() != metadataRecordId && key.Field() == headerFieldId:
+			header, ok := value.(recordHeader)
+			if !ok {
+				return fmt.Errorf("rebuild: stored value is not header (key %s)", key)
+			}
+			if header.seq > s.seq {
+				s.seq = header.seq
+			}
+			if header.removedSeq > s.seq {
+				s.seq = header.removedSeq
+			}
+			if header.deleted {
+				if header.next == 0 && !header.removed.IsZero() {
+					s.addTombstone(section, header.guid, key)
+				}
+				continue
+			}
+			if s.records[section] == nil {
+				s.records[section] = make(map[GUIDType]Key)
+			}
+			s.records[section][header.guid] = key
+		}
+	}
+	return s.buildIndexes()
 }
 
 func (s *Stash) newId(section SectionIdType) RecordIdType {
@@ -90,10 +491,12 @@ func (s *Stash) newId(section SectionIdType) RecordIdType {
 	var firstId uint64 = 1
 	aid, loaded := s.m.LoadOrStore(key, &firstId)
 	if !loaded {
-		s.put(key) // todo: move to init section
+		s.store(key, &firstId) // todo: move to init section
 		return RecordIdType(firstId)
 	}
-	return RecordIdType(atomic.AddUint64(aid.(*uint64), 1))
+	id := atomic.AddUint64(aid.(*uint64), 1)
+	s.pending = append(s.pending, walMutation{key: key, value: aid})
+	return RecordIdType(id)
 }
 
 //func (s *Stash) findRecord(section SectionIdType, record RecordIdType, field FieldIdType) (*redBlackNode, bool) {
@@ -159,8 +562,7 @@ func (s *Stash) fieldIdSFG(section SectionIdType, fieldName string) FieldIdType
 			if !ok {
 				fid = FieldIdType(len(s.fields[section]) + 1)
 				key := NewKey(section, metadataRecordId, fid)
-				s.m.Store(key, fieldName)
-				s.put(key)
+				s.store(key, fieldName)
 				s.fields[section][fieldName] = fid
 			}
 			return fid, nil
@@ -282,9 +684,9 @@ func (s *Stash) putHeader(section SectionIdType, f func() recordHeader) (GUIDTyp
 	recId := s.newId(section)
 	key := NewKey(section, recId, headerFieldId)
 	header := f()
-	s.m.Store(key, header)
+	header.seq = s.nextSeq()
+	s.store(key, header)
 	s.recordAddSFG(section, header.guid, key)
-	s.put(key)
 
 	s.sugar.Debugw("put header", "operation", header.operation, "guid", header.guid, "key", key)
 	return header.guid, recId
@@ -294,22 +696,43 @@ func (s *Stash) putData(section SectionIdType, recId RecordIdType, data map[stri
 	for name, value := range data {
 		fid := s.fieldIdSFG(section, name)
 		key := NewKey(section, recId, fid)
-		s.m.Store(key, value)
-		s.put(key)
+		s.store(key, value)
 		s.sugar.Debugw("put data", "name", name, "key", key)
 	}
 }
 
 // Insert data
-func (s *Stash) Insert(section SectionIdType, data map[string]any) GUIDType {
+func (s *Stash) Insert(section SectionIdType, data map[string]any, opts ...WriteOption) (GUIDType, error) {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	guid := newGUID()
+	if err = s.checkUnique(section, guid, data); err != nil {
+		return "", err
+	}
+	s.insert(section, guid, data, w)
+	return guid, s.commit()
+}
+
+// insert writes the first version of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) insert(section SectionIdType, guid GUIDType, data map[string]any, w writeOptions) GUIDType {
 	guid, recId := s.putHeader(section, func() recordHeader {
 		header := newRecordHeader(InsertOperation)
+		header.guid = guid
+		header.user = w.user
+		header.expires = s.expiresAt(section, w)
 		return header
 	})
 	s.putData(section, recId, data)
+	s.indexPut(section, guid, data)
+	s.addEvent(section, guid, InsertOperation, data)
 
 	return guid
 }
@@ -319,17 +742,24 @@ func (s *Stash) Get(section SectionIdType, guid GUIDType) (map[string]any, error
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	key, err := s.recordKeySFG(section, guid)
+	key, err := s.liveKey(section, guid)
 	if err != nil {
 		return nil, err
 	}
 	s.sugar.Debugw("get", "guid", guid, "key", key)
 
+	return s.getData(key)
+}
+
+// getData reads all fields of the record by the header key
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) getData(key Key) (map[string]any, error) {
 	node := s.get(key)
 	if node == nil {
 		return nil, ErrRecordNotFound
 	}
-	recId := node.key.Record()
+	section, recId := node.key.Section(), node.key.Record()
 
 	res := make(map[string]any)
 	it := s.iteratorAt(node)
@@ -360,10 +790,25 @@ func (s *Stash) Get(section SectionIdType, guid GUIDType) (map[string]any, error
 }
 
 // Remove data
-func (s *Stash) Remove(section SectionIdType, guid GUIDType) error {
+func (s *Stash) Remove(section SectionIdType, guid GUIDType, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if err = s.removeRecord(section, guid, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// removeRecord marks the record as removed
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) removeRecord(section SectionIdType, guid GUIDType, w writeOptions) error {
 	key, err := s.recordRemoveSFG(section, guid)
 	if err != nil {
 		return err
@@ -375,41 +820,133 @@ func (s *Stash) Remove(section SectionIdType, guid GUIDType) error {
 	if err != nil {
 		return err
 	}
+	var data map[string]any
+	if data, err = s.getData(key); err != nil {
+		return err
+	}
+
 	header.deleted = true
-	s.m.Store(key, header)
+	header.removed = time.Now()
+	header.removedSeq = s.nextSeq()
+	header.removedBy = w.user
+	s.store(key, header)
+	s.addTombstone(section, guid, key)
+	s.indexDrop(section, guid)
+	s.addEvent(section, guid, RemoveOperation, data)
 
 	return nil
 }
 
 // Update data
-func (s *Stash) Update(section SectionIdType, guid GUIDType, data map[string]any) error {
+func (s *Stash) Update(section SectionIdType, guid GUIDType, data map[string]any, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	prevKey, err := s.recordKeySFG(section, guid)
+	if err = s.update(section, guid, UpdateOperation, data, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// Patch writes the new version of the record with fields carried forward from the current version,
+// data fields are set and fields from remove are deleted
+func (s *Stash) Patch(section SectionIdType, guid GUIDType, data map[string]any, remove []string, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
 	if err != nil {
 		return err
 	}
 
-	var prevHeader recordHeader
-	prevHeader, err = s.getRecordHeader(prevKey)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.patch(section, guid, data, remove, w); err != nil {
+		return err
+	}
+	return s.commit()
+}
+
+// patch writes the new version of the record merged with the current version
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) patch(section SectionIdType, guid GUIDType, data map[string]any, remove []string, w writeOptions) error {
+	key, err := s.liveKey(section, guid)
+	if err != nil {
+		return err
+	}
+
+	var merged map[string]any
+	merged, err = s.getData(key)
+	if err != nil {
+		return err
+	}
+	for _, name := range remove {
+		delete(merged, name)
+	}
+	for name, value := range data {
+		merged[name] = value
+	}
+
+	return s.update(section, guid, UpdateOperation, merged, w)
+}
+
+// update writes the new version of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) update(section SectionIdType, guid GUIDType, op OperationType, data map[string]any, w writeOptions) error {
+	prevKey, err := s.liveKey(section, guid)
+	if err != nil {
+		return err
+	}
+	if err = s.checkUnique(section, guid, data); err != nil {
+		return err
+	}
+	return s.putVersion(prevKey, op, data, w)
+}
+
+// putVersion writes the new version of the record after the version prevKey
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) putVersion(prevKey Key, op OperationType, data map[string]any, w writeOptions) error {
+	section := prevKey.Section()
+	cfg := s.sections[section]
+	if cfg.Versioning == NoHistory {
+		return s.overwrite(prevKey, op, data, w)
+	}
+
+	prevHeader, err := s.getRecordHeader(prevKey)
 	if err != nil {
 		return err
 	}
 	prevHeader.deleted = true
-	prevHeader.time = time.Now()
 
 	guid, recId := s.putHeader(section, func() recordHeader {
-		header := newRecordHeader(UpdateOperation)
-		header.guid = guid
+		header := newRecordHeader(op)
+		header.guid = prevHeader.guid
+		header.prev = prevKey.Record()
+		header.revision = prevHeader.revision + 1
+		header.user = w.user
+		header.expires = s.nextExpiresAt(section, w, prevHeader.expires)
 		return header
 	})
 	s.putData(section, recId, data)
+	s.indexPut(section, guid, data)
+	s.addEvent(section, guid, op, data)
 
 	prevHeader.next = recId
-	s.m.Store(prevKey, prevHeader)
+	s.store(prevKey, prevHeader)
 
-	s.sugar.Debugw("update", "guid", guid, "prevKey", prevKey)
+	if keep := cfg.keepVersions(); keep > 0 {
+		if _, _, err = s.prune(NewKey(section, recId, headerFieldId), keep); err != nil {
+			return err
+		}
+	}
+
+	s.sugar.Debugw("update", "operation", op, "guid", guid, "prevKey", prevKey)
 	return nil
 }
 
@@ -427,11 +964,12 @@ func (s *Stash) Find(ctx context.Context, section SectionIdType, f func(*map[str
 	// todo: run s.Get in goroutines with context
 	for guid := range recordsInSection {
 		data, err := s.Get(section, guid)
-		if err != nil && !errors.Is(ErrRecordNotFound, err) {
-			return nil, err
+		if errors.Is(err, ErrRecordNotFound) {
+			// removed meanwhile or expired
+			continue
 		}
 		if err != nil {
-			s.sugar.Warnw("get", "err", err)
+			return nil, err
 		}
 
 		ok, stop := true, false
@@ -10,7 +10,8 @@ import (
 )
 
 func Test_stash_Insert(t *testing.T) {
-	s := NewStash(getTestLogger())
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
 	require.NotNil(t, s)
 
 	to := map[string]any{
@@ -19,7 +20,8 @@ func Test_stash_Insert(t *testing.T) {
 		"int_val": 100,
 	}
 
-	recGuid := s.Insert(1, to)
+	recGuid, err := s.Insert(1, to)
+	require.NoError(t, err)
 	require.EqualValues(t, true, recGuid != "")
 
 	from, err := s.Get(1, recGuid)
@@ -28,7 +30,8 @@ func Test_stash_Insert(t *testing.T) {
 }
 
 func Test_stash_inGoroutines(t *testing.T) {
-	s := NewStash(getTestLogger())
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
 	require.NotNil(t, s)
 
 	goroutinesCount := 100
@@ -44,7 +47,8 @@ func Test_stash_inGoroutines(t *testing.T) {
 			"int_val" + strconv.Itoa(i): i,
 		}
 
-		recGuid := s.Insert(1, to)
+		recGuid, err := s.Insert(1, to)
+		require.NoError(t, err)
 		keyInsert, err := s.recordKeySFG(1, recGuid)
 		require.NoError(t, err, "guid=%s err=%v", recGuid, err)
 		require.EqualValues(t, true, recGuid != "")
@@ -64,7 +68,8 @@ func Test_stash_inGoroutines(t *testing.T) {
 			"int_val" + strconv.Itoa(i): i,
 		}
 
-		recGuid := s.Insert(1, to)
+		recGuid, err := s.Insert(1, to)
+		require.NoError(t, err)
 		keyInsert, _ := s.recordKeySFG(1, recGuid)
 		require.EqualValues(t, true, recGuid != "")
 
@@ -89,7 +94,8 @@ func Test_stash_inGoroutines(t *testing.T) {
 			"int_val" + strconv.Itoa(i): i,
 		}
 
-		recGuid := s.Insert(1, to)
+		recGuid, err := s.Insert(1, to)
+		require.NoError(t, err)
 		keyInsert, _ := s.recordKeySFG(1, recGuid)
 		require.EqualValues(t, true, recGuid != "")
 
@@ -128,7 +134,8 @@ func Test_stash_inGoroutines(t *testing.T) {
 }
 
 func Test_stash_Find(t *testing.T) {
-	s := NewStash(getTestLogger())
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
 	require.NotNil(t, s)
 
 	to := []map[string]any{
@@ -150,7 +157,8 @@ func Test_stash_Find(t *testing.T) {
 	}
 
 	for _, m := range to {
-		recGuid := s.Insert(1, m)
+		recGuid, err := s.Insert(1, m)
+		require.NoError(t, err)
 		require.EqualValues(t, true, recGuid != "")
 	}
 
@@ -166,3 +174,28 @@ func Test_stash_Find(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, 2, len(records))
 }
+
+func Test_stash_Patch(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	guid, err := s.Insert(1, map[string]any{
+		"tag":     "#tag1",
+		"text":    "sample text",
+		"int_val": 1,
+	})
+	require.NoError(t, err)
+
+	err = s.Patch(1, guid, map[string]any{"int_val": 2, "new_val": "new"}, []string{"text"})
+	require.NoError(t, err)
+
+	from, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#tag1", "int_val": 2, "new_val": "new"}, from)
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+
+	require.ErrorIs(t, s.Patch(1, "unknown", nil, nil), ErrRecordNotFound)
+}
@@ -0,0 +1,81 @@
+package stashdb
+
+import (
+	"sort"
+	"time"
+)
+
+// DeletedRecord the removed record which can be restored
+type DeletedRecord struct {
+	Guid    GUIDType
+	Removed time.Time
+}
+
+// addTombstone remembers the last version of the removed record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) addTombstone(section SectionIdType, guid GUIDType, key Key) {
+	if s.tombstones[section] == nil {
+		s.tombstones[section] = make(map[GUIDType]Key)
+	}
+	s.tombstones[section][guid] = key
+}
+
+// Restore brings back the last live version of the removed record as the new version
+// with RestoreOperation in the history
+func (s *Stash) Restore(section SectionIdType, guid GUIDType, opts ...WriteOption) error {
+	w, err := newWriteOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.recordKeySFG(section, guid); err == nil {
+		return ErrRecordIsAlive
+	}
+
+	key, ok := s.tombstones[section][guid]
+	if !ok {
+		return ErrRecordNotFound
+	}
+
+	data, err := s.getData(key)
+	if err != nil {
+		return err
+	}
+	if err = s.checkUnique(section, guid, data); err != nil {
+		return err
+	}
+	if err = s.putVersion(key, RestoreOperation, data, w); err != nil {
+		return err
+	}
+	delete(s.tombstones[section], guid)
+
+	s.sugar.Debugw("restore", "guid", guid, "key", key)
+	return s.commit()
+}
+
+// ListDeleted returns removed records of the section sorted by the removal time
+func (s *Stash) ListDeleted(section SectionIdType) ([]DeletedRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	deleted := make([]DeletedRecord, 0, len(s.tombstones[section]))
+	for guid, key := range s.tombstones[section] {
+		header, err := s.getRecordHeader(key)
+		if err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, DeletedRecord{
+			Guid:    guid,
+			Removed: header.removed,
+		})
+	}
+
+	sort.Slice(deleted, func(i, j int) bool {
+		return deleted[i].Removed.Before(deleted[j].Removed)
+	})
+	return deleted, nil
+}
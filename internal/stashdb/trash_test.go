@@ -0,0 +1,63 @@
+package stashdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Restore(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	v1 := map[string]any{"tag": "#tag1"}
+	v2 := map[string]any{"tag": "#tag2"}
+
+	guid, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guid, v2))
+	other, err := s.Insert(1, v1)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, s.Restore(1, guid), ErrRecordIsAlive)
+
+	require.NoError(t, s.Remove(1, guid))
+	time.Sleep(time.Millisecond)
+	removed := time.Now()
+	time.Sleep(time.Millisecond)
+	require.NoError(t, s.Remove(1, other))
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	deleted, err := s.ListDeleted(1)
+	require.NoError(t, err)
+	require.Len(t, deleted, 2)
+	require.Equal(t, guid, deleted[0].Guid)
+	require.Equal(t, other, deleted[1].Guid)
+	require.True(t, deleted[0].Removed.Before(deleted[1].Removed))
+
+	require.NoError(t, s.Restore(1, guid))
+
+	from, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, v2, from)
+
+	deleted, err = s.ListDeleted(1)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	require.Equal(t, RestoreOperation, versions[2].Operation)
+
+	_, err = s.GetAsOf(1, guid, removed)
+	require.ErrorIs(t, err, ErrRecordNotFound, "record was removed at this time")
+
+	require.ErrorIs(t, s.Restore(1, "unknown"), ErrRecordNotFound)
+}
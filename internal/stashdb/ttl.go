@@ -0,0 +1,154 @@
+package stashdb
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidTTL = errors.New("invalid ttl")
+
+// expired reports whether the version is not visible at now
+func (h recordHeader) expired(now time.Time) bool {
+	return !h.expires.IsZero() && !now.Before(h.expires)
+}
+
+// WithTTL sets the version to expire after ttl from now, 0 - the section default (SectionConfig.TTL).
+// Without it the new version keeps the expiration time of the previous one, Insert uses the section default.
+// The expired record is invisible at once and is removed by the reaper (see WithReapInterval and Reap).
+func WithTTL(ttl time.Duration) WriteOption {
+	return func(w *writeOptions) {
+		w.ttl = &ttl
+	}
+}
+
+// Reap removes expired records like Remove does, so they get into the history, the trash and watchers,
+// returns the number of removed records
+func (s *Stash) Reap() (int, error) {
+	now := time.Now()
+
+	type expiredRecord struct {
+		section SectionIdType
+		guid    GUIDType
+	}
+	var expired []expiredRecord
+
+	s.mu.RLock()
+	for section, records := range s.records {
+		for guid, key := range records {
+			header, err := s.getRecordHeader(key)
+			if err != nil {
+				s.mu.RUnlock()
+				return 0, err
+			}
+			if header.expired(now) {
+				expired = append(expired, expiredRecord{section, guid})
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	var removed int
+	for _, r := range expired {
+		ok, err := s.reap(r.section, r.guid, now)
+		if err != nil {
+			return removed, err
+		}
+		if ok {
+			removed++
+		}
+	}
+
+	s.sugar.Debugw("reap", "removed", removed)
+	return removed, nil
+}
+
+// reap removes the record if it is still expired at now, it could be removed or updated meanwhile
+func (s *Stash) reap(section SectionIdType, guid GUIDType, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := s.recordKeySFG(section, guid)
+	if errors.Is(err, ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var header recordHeader
+	if header, err = s.getRecordHeader(key); err != nil {
+		return false, err
+	}
+	if !header.expired(now) {
+		return false, nil
+	}
+
+	// the system removes the record, the user is empty
+	if err = s.removeRecord(section, guid, writeOptions{}); err != nil {
+		return false, err
+	}
+	return true, s.commit()
+}
+
+// reapLoop removes expired records every interval until the Stash is closed
+func (s *Stash) reapLoop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if _, err := s.Reap(); err != nil {
+				s.sugar.Errorw("reap", "err", err)
+			}
+		}
+	}
+}
+
+// liveKey returns the header key of the live not expired record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) liveKey(section SectionIdType, guid GUIDType) (Key, error) {
+	key, err := s.recordKeySFG(section, guid)
+	if err != nil {
+		return key, err
+	}
+	header, err := s.getRecordHeader(key)
+	if err != nil {
+		return key, err
+	}
+	if header.expired(time.Now()) {
+		return key, ErrRecordNotFound
+	}
+	return key, nil
+}
+
+// expiresAt returns the expiration time of the first version written now, zero - never
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) expiresAt(section SectionIdType, w writeOptions) time.Time {
+	var ttl time.Duration
+	if w.ttl != nil {
+		ttl = *w.ttl
+	}
+	if ttl == 0 {
+		ttl = s.sections[section].TTL
+	}
+	if ttl == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
+
+// nextExpiresAt returns the expiration time of the version written now after the version expiring at prev.
+// prev is kept unless w sets the ttl or it has passed, so the restored expired record gets the new one.
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) nextExpiresAt(section SectionIdType, w writeOptions, prev time.Time) time.Time {
+	if w.ttl == nil && (prev.IsZero() || time.Now().Before(prev)) {
+		return prev
+	}
+	return s.expiresAt(section, w)
+}
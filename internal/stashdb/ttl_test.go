@@ -0,0 +1,154 @@
+package stashdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_TTL(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	_, err = s.Insert(1, map[string]any{"n": int64(0)}, WithTTL(-time.Second))
+	require.ErrorIs(t, err, ErrInvalidTTL)
+
+	short, err := s.Insert(1, map[string]any{"n": int64(1)}, WithTTL(20*time.Millisecond))
+	require.NoError(t, err)
+	long, err := s.Insert(1, map[string]any{"n": int64(2)}, WithTTL(time.Hour))
+	require.NoError(t, err)
+	forever, err := s.Insert(1, map[string]any{"n": int64(3)})
+	require.NoError(t, err)
+	require.NoError(t, s.CreateIndex(1, "n"))
+
+	_, err = s.Get(1, short)
+	require.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	_, err = s.Get(1, short)
+	require.ErrorIs(t, err, ErrRecordNotFound, "expired before the reaper runs")
+	require.ErrorIs(t, s.Update(1, short, map[string]any{"n": int64(10)}), ErrRecordNotFound)
+	records, err := s.Query(context.Background(), 1, Query{Filter: "n >= 0"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{long, forever}, guidsOf(records))
+	records, err = s.Lookup(1, "n", int64(1))
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, _, err = s.ScanBatch(1, 0, 10)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{long, forever}, guidsOf(records))
+	records, err = s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{long, forever}, guidsOf(records))
+	records, err = s.Find(context.Background(), 1, func(data *map[string]any) (bool, bool) {
+		require.NotNil(t, *data, "expired records are not passed to the filter")
+		return true, false
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	require.NoError(t, s.Close())
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	_, err = s.Get(1, short)
+	require.ErrorIs(t, err, ErrRecordNotFound, "expiration time is persisted")
+
+	removed, err := s.Reap()
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	deleted, err := s.ListDeleted(1)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, short, deleted[0].Guid, "reaped record goes to the trash")
+
+	removed, err = s.Reap()
+	require.NoError(t, err)
+	require.Zero(t, removed)
+}
+
+func Test_stash_TTL_sectionDefault(t *testing.T) {
+	s, err := NewStash(getTestLogger(), WithReapInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.ErrorIs(t, s.SetSectionConfig(1, SectionConfig{TTL: -time.Second}), ErrInvalidConfig)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{TTL: 30 * time.Millisecond}))
+	require.Equal(t, 30*time.Millisecond, s.SectionConfig(1).TTL)
+
+	guid, err := s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+	kept, err := s.Insert(1, map[string]any{"n": int64(2)})
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, kept, map[string]any{"n": int64(3)}, WithTTL(time.Hour)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var events []Event
+	err = s.Watch(ctx, WatchOptions{Section: 1, From: 1}, func(e Event) error {
+		if e.Operation == RemoveOperation {
+			events = append(events, e)
+			return errStopWatch
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStopWatch, "the reaper removes the record")
+	require.Equal(t, guid, events[0].Guid)
+
+	_, err = s.Get(1, kept)
+	require.NoError(t, err, "explicit ttl overrides the section default")
+}
+
+func Test_stash_TTL_carried(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	_, err = s.Insert(1, map[string]any{"n": int64(0)}, WithTTL(-time.Second))
+	require.ErrorIs(t, err, ErrInvalidTTL)
+	tx := s.Begin(WithTTL(-time.Second))
+	_, err = tx.Insert(1, map[string]any{"n": int64(0)})
+	require.NoError(t, err)
+	require.ErrorIs(t, tx.Commit(), ErrInvalidTTL)
+
+	ttl := WithTTL(50 * time.Millisecond)
+	patched, err := s.Insert(1, map[string]any{"n": int64(1)}, ttl)
+	require.NoError(t, err)
+	require.NoError(t, s.Patch(1, patched, map[string]any{"m": int64(1)}, nil))
+
+	conditional, err := s.Insert(1, map[string]any{"n": int64(2)}, ttl)
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateIf(1, conditional, map[string]any{"n": int64(3)}, 1))
+	require.NoError(t, s.PatchIf(1, conditional, map[string]any{"m": int64(1)}, nil, 2))
+	require.NoError(t, s.Revert(1, conditional, 1))
+
+	inTx, err := s.Insert(1, map[string]any{"n": int64(4)}, ttl)
+	require.NoError(t, err)
+	tx = s.Begin()
+	require.NoError(t, tx.Update(1, inTx, map[string]any{"n": int64(5)}))
+	require.NoError(t, tx.Commit())
+
+	extended, err := s.Insert(1, map[string]any{"n": int64(6)}, ttl)
+	require.NoError(t, err)
+	require.NoError(t, s.Patch(1, extended, map[string]any{"m": int64(1)}, nil, WithTTL(time.Hour)))
+
+	time.Sleep(60 * time.Millisecond)
+	for _, guid := range []GUIDType{patched, conditional, inTx} {
+		_, err = s.Get(1, guid)
+		require.ErrorIs(t, err, ErrRecordNotFound, "writes without ttl keep the expiration time")
+	}
+	_, err = s.Get(1, extended)
+	require.NoError(t, err, "the new ttl replaces the carried one")
+
+	removed, err := s.Reap()
+	require.NoError(t, err)
+	require.Equal(t, 3, removed)
+
+	require.NoError(t, s.Restore(1, patched))
+	_, err = s.Get(1, patched)
+	require.NoError(t, err, "the expired time is not carried to the restored record")
+}
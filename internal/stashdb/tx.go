@@ -0,0 +1,265 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrTxDone     = errors.New("transaction has already been committed or rolled back")
+	ErrTxConflict = errors.New("transaction conflict")
+)
+
+type txOpKind byte
+
+const (
+	txInsert txOpKind = iota
+	txUpdate
+	txRemove
+)
+
+// txOp the buffered write of the transaction
+type txOp struct {
+	kind    txOpKind
+	section SectionIdType
+	guid    GUIDType
+	data    map[string]any
+}
+
+type txRecordKey struct {
+	section SectionIdType
+	guid    GUIDType
+}
+
+// txRecord the record state as the transaction sees it
+type txRecord struct {
+	live bool
+	data map[string]any
+}
+
+// Tx the transaction. Writes are buffered until Commit and are applied all or none,
+// reads see committed data and own writes only.
+type Tx struct {
+	stash *Stash
+
+	mu      sync.Mutex
+	ops     []txOp
+	records map[txRecordKey]txRecord
+	// reads holds revisions of committed records read by the transaction, 0 - the record was not found
+	reads map[txRecordKey]uint64
+	done  bool
+	// w applies to all writes of the transaction
+	w writeOptions
+	// err the invalid write option, it is returned by Commit
+	err error
+}
+
+// Begin starts the transaction, opts apply to all its writes, the invalid option is returned by Commit
+func (s *Stash) Begin(opts ...WriteOption) *Tx {
+	w, err := newWriteOptions(opts)
+	return &Tx{
+		stash:   s,
+		records: make(map[txRecordKey]txRecord),
+		reads:   make(map[txRecordKey]uint64),
+		w:       w,
+		err:     err,
+	}
+}
+
+// Insert buffers the insert, the guid is known before Commit
+func (tx *Tx) Insert(section SectionIdType, data map[string]any) (GUIDType, error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return "", ErrTxDone
+	}
+
+	guid := newGUID()
+	tx.ops = append(tx.ops, txOp{kind: txInsert, section: section, guid: guid, data: data})
+	tx.records[txRecordKey{section, guid}] = txRecord{live: true, data: data}
+	return guid, nil
+}
+
+// Update buffers the update of the live record
+func (tx *Tx) Update(section SectionIdType, guid GUIDType, data map[string]any) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return ErrTxDone
+	}
+	if _, err := tx.get(section, guid); err != nil {
+		return err
+	}
+
+	tx.ops = append(tx.ops, txOp{kind: txUpdate, section: section, guid: guid, data: data})
+	tx.records[txRecordKey{section, guid}] = txRecord{live: true, data: data}
+	return nil
+}
+
+// Remove buffers the removal of the live record
+func (tx *Tx) Remove(section SectionIdType, guid GUIDType) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return ErrTxDone
+	}
+	if _, err := tx.get(section, guid); err != nil {
+		return err
+	}
+
+	tx.ops = append(tx.ops, txOp{kind: txRemove, section: section, guid: guid})
+	tx.records[txRecordKey{section, guid}] = txRecord{live: false}
+	return nil
+}
+
+// Get returns the record with the writes of the transaction applied
+func (tx *Tx) Get(section SectionIdType, guid GUIDType) (map[string]any, error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return nil, ErrTxDone
+	}
+	return tx.get(section, guid)
+}
+
+func (tx *Tx) get(section SectionIdType, guid GUIDType) (map[string]any, error) {
+	key := txRecordKey{section, guid}
+	rec, ok := tx.records[key]
+	if !ok {
+		data, revision, err := tx.stash.GetRevision(section, guid)
+		if err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return nil, err
+		}
+		// the first read is checked by Commit, a later different one is a conflict anyway
+		if _, read := tx.reads[key]; !read {
+			tx.reads[key] = revision
+		}
+		return data, err
+	}
+	if !rec.live {
+		return nil, ErrRecordNotFound
+	}
+
+	res := make(map[string]any, len(rec.data))
+	for name, value := range rec.data {
+		res[name] = value
+	}
+	return res, nil
+}
+
+// Rollback discards the buffered writes
+func (tx *Tx) Rollback() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	tx.ops = nil
+	return nil
+}
+
+// Commit applies all buffered writes atomically and logs them as one write-ahead log frame.
+// ErrTxConflict if a record read by the transaction was changed by others since the read
+// or a write can't be applied, nothing is applied in this case.
+func (tx *Tx) Commit() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	if tx.err != nil {
+		return tx.err
+	}
+
+	s := tx.stash
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validateTx(tx.ops, tx.reads); err != nil {
+		return err
+	}
+
+	for _, op := range tx.ops {
+		var err error
+		switch op.kind {
+		case txInsert:
+			s.insert(op.section, op.guid, op.data, tx.w)
+		case txUpdate:
+			// unique constraints are checked for the state after the transaction by validateTx
+			var key Key
+			if key, err = s.recordKeySFG(op.section, op.guid); err == nil {
+				err = s.putVersion(key, UpdateOperation, op.data, tx.w)
+			}
+		case txRemove:
+			err = s.removeRecord(op.section, op.guid, tx.w)
+		}
+		if err != nil {
+			// impossible after the validation, nothing is applied anyway
+			s.sugar.Errorw("tx apply", "guid", op.guid, "err", err)
+			if aerr := s.abort(); aerr != nil {
+				s.sugar.Errorw("tx abort", "err", aerr)
+			}
+			return err
+		}
+	}
+
+	s.sugar.Debugw("tx commit", "ops", len(tx.ops))
+	return s.commit()
+}
+
+// validateTx checks that records read by the transaction have the same revisions
+// and ops can be applied to the current state
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) validateTx(ops []txOp, reads map[txRecordKey]uint64) error {
+	for key, read := range reads {
+		var current uint64
+		headerKey, err := s.liveKey(key.section, key.guid)
+		if err == nil {
+			var header recordHeader
+			if header, err = s.getRecordHeader(headerKey); err != nil {
+				return err
+			}
+			current = header.revision
+		} else if !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+		if current != read {
+			return fmt.Errorf("%w: guid %s: read revision %d, current %d: %v",
+				ErrTxConflict, key.guid, read, current, ErrVersionConflict)
+		}
+	}
+
+	live := make(map[txRecordKey]bool)
+	for i, op := range ops {
+		key := txRecordKey{op.section, op.guid}
+		isLive, ok := live[key]
+		if !ok {
+			_, err := s.liveKey(op.section, op.guid)
+			isLive = err == nil
+		}
+
+		switch op.kind {
+		case txInsert:
+			if isLive {
+				return fmt.Errorf("%w: op %d: guid %s already exists", ErrTxConflict, i, op.guid)
+			}
+			live[key] = true
+		case txUpdate, txRemove:
+			if !isLive {
+				return fmt.Errorf("%w: op %d: guid %s: %v", ErrTxConflict, i, op.guid, ErrRecordNotFound)
+			}
+			live[key] = op.kind == txUpdate
+		}
+	}
+	return s.validateTxUnique(ops)
+}
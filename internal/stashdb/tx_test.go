@@ -0,0 +1,172 @@
+package stashdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_TxCommit(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	stock, err := s.Insert(2, map[string]any{"amount": int64(10)})
+	require.NoError(t, err)
+	removed, err := s.Insert(2, map[string]any{"amount": int64(1)})
+	require.NoError(t, err)
+
+	tx := s.Begin()
+	order, err := tx.Insert(1, map[string]any{"amount": int64(3)})
+	require.NoError(t, err)
+	require.NoError(t, tx.Update(2, stock, map[string]any{"amount": int64(7)}))
+	require.NoError(t, tx.Remove(2, removed))
+
+	from, err := tx.Get(2, stock)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"amount": int64(7)}, from, "own writes are visible")
+	_, err = tx.Get(2, removed)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = s.Get(1, order)
+	require.ErrorIs(t, err, ErrRecordNotFound, "uncommitted insert is invisible")
+	from, err = s.Get(2, stock)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"amount": int64(10)}, from, "uncommitted update is invisible")
+
+	require.NoError(t, tx.Commit())
+	require.ErrorIs(t, tx.Commit(), ErrTxDone)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	from, err = s.Get(1, order)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"amount": int64(3)}, from)
+	from, err = s.Get(2, stock)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"amount": int64(7)}, from)
+	_, err = s.Get(2, removed)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func Test_stash_TxConflict(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	stock, err := s.Insert(2, map[string]any{"amount": int64(10)})
+	require.NoError(t, err)
+
+	tx := s.Begin()
+	order, err := tx.Insert(1, map[string]any{"amount": int64(3)})
+	require.NoError(t, err)
+	require.NoError(t, tx.Update(2, stock, map[string]any{"amount": int64(7)}))
+
+	require.NoError(t, s.Remove(2, stock))
+	require.ErrorIs(t, tx.Commit(), ErrTxConflict)
+
+	_, err = s.Get(1, order)
+	require.ErrorIs(t, err, ErrRecordNotFound, "nothing is applied")
+
+	tx = s.Begin()
+	_, err = tx.Insert(1, map[string]any{"amount": int64(3)})
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.ErrorIs(t, tx.Commit(), ErrTxDone)
+
+	records, err := s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 0)
+}
+
+func Test_stash_TxLostUpdate(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	counter, err := s.Insert(1, map[string]any{"n": int64(0)})
+	require.NoError(t, err)
+
+	increment := func(tx *Tx) {
+		data, err := tx.Get(1, counter)
+		require.NoError(t, err)
+		require.NoError(t, tx.Update(1, counter, map[string]any{"n": data["n"].(int64) + 1}))
+	}
+	first, second := s.Begin(), s.Begin()
+	increment(first)
+	increment(second)
+	require.NoError(t, first.Commit())
+	err = second.Commit()
+	require.ErrorIs(t, err, ErrTxConflict, "the second increment read the old revision")
+
+	data, err := s.Get(1, counter)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), data["n"])
+
+	// the record read only is checked too
+	limit, err := s.Insert(1, map[string]any{"max": int64(5)})
+	require.NoError(t, err)
+	tx := s.Begin()
+	_, err = tx.Get(1, limit)
+	require.NoError(t, err)
+	increment(tx)
+	require.NoError(t, s.Update(1, limit, map[string]any{"max": int64(1)}))
+	require.ErrorIs(t, tx.Commit(), ErrTxConflict)
+
+	// so is the record which was not found
+	tx = s.Begin()
+	_, err = tx.Get(1, limit)
+	require.NoError(t, err)
+	require.NoError(t, s.Remove(1, limit))
+	increment(tx)
+	require.ErrorIs(t, tx.Commit(), ErrTxConflict)
+
+	tx = s.Begin()
+	_, err = tx.Get(1, limit)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	increment(tx)
+	require.NoError(t, s.Restore(1, limit))
+	require.ErrorIs(t, tx.Commit(), ErrTxConflict, "the record restored after the read")
+
+	data, err = s.Get(1, counter)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), data["n"], "conflicting transactions change nothing")
+}
+
+func Test_stash_TxAbort(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.CreateIndex(1, "n"))
+
+	guid, err := s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+
+	// the apply error after the validation
+	s.mu.Lock()
+	other := newGUID()
+	s.insert(1, other, map[string]any{"n": int64(2), "new": true}, writeOptions{})
+	key, err := s.recordKeySFG(1, guid)
+	require.NoError(t, err)
+	require.NoError(t, s.putVersion(key, UpdateOperation, map[string]any{"n": int64(3)}, writeOptions{}))
+	require.NoError(t, s.abort())
+	s.mu.Unlock()
+
+	_, err = s.Get(1, other)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	data, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"n": int64(1)}, data)
+	records, err := s.Lookup(1, "n", int64(3))
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = s.Lookup(1, "n", int64(1))
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{guid}, guidsOf(records))
+
+	require.NoError(t, s.Update(1, guid, map[string]any{"n": int64(4)}))
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 2, "the aborted version is not in the history")
+}
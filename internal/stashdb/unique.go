@@ -0,0 +1,99 @@
+package stashdb
+
+import (
+	"fmt"
+)
+
+// ErrUniqueViolation the write would make the value of the unique field equal to the value of another live record
+type ErrUniqueViolation struct {
+	Field string
+	// Guid the live record which already holds the value
+	Guid GUIDType
+}
+
+func (e ErrUniqueViolation) Error() string {
+	return fmt.Sprintf("unique violation: field %s is held by guid %s", e.Field, e.Guid)
+}
+
+// checkUnique checks that the new version of the record with guid doesn't break unique constraints of the section
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) checkUnique(section SectionIdType, guid GUIDType, data map[string]any) error {
+	for field, idx := range s.indexes[section] {
+		if !idx.unique {
+			continue
+		}
+		if holder, ok := s.uniqueHolder(section, idx, data[field], guid); ok {
+			return ErrUniqueViolation{Field: field, Guid: holder}
+		}
+	}
+	return nil
+}
+
+// validateTxUnique checks that the state after the transaction doesn't break unique constraints,
+// records changed by the transaction release their old values
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) validateTxUnique(ops []txOp) error {
+	final := make(map[txRecordKey]txRecord)
+	for _, op := range ops {
+		final[txRecordKey{op.section, op.guid}] = txRecord{live: op.kind != txRemove, data: op.data}
+	}
+
+	type uniqueValue struct {
+		section SectionIdType
+		field   string
+		value   any
+	}
+	taken := make(map[uniqueValue]GUIDType)
+
+	for key, rec := range final {
+		if !rec.live {
+			continue
+		}
+		for field, idx := range s.indexes[key.section] {
+			if !idx.unique {
+				continue
+			}
+			v, ok := indexValue(rec.data[field])
+			if !ok {
+				continue
+			}
+
+			uv := uniqueValue{key.section, field, v}
+			if other, ok := taken[uv]; ok {
+				return ErrUniqueViolation{Field: field, Guid: other}
+			}
+			taken[uv] = key.guid
+
+			for _, holder := range idx.holders(v) {
+				if holder == key.guid {
+					continue
+				}
+				if _, changed := final[txRecordKey{key.section, holder}]; changed {
+					continue
+				}
+				if _, err := s.liveKey(key.section, holder); err == nil {
+					return ErrUniqueViolation{Field: field, Guid: holder}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// uniqueHolder returns the live not expired record other than guid holding the value in the index,
+// expired records keep their values in the index until the reaper removes them
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) uniqueHolder(section SectionIdType, idx *index, value any, guid GUIDType) (GUIDType, bool) {
+	for _, holder := range idx.holders(value) {
+		if holder == guid {
+			continue
+		}
+		if _, err := s.liveKey(section, holder); err == nil {
+			return holder, true
+		}
+	}
+	return "", false
+}
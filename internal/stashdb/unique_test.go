@@ -0,0 +1,120 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Unique(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.CreateUniqueIndex(1, "email"))
+	require.Equal(t, []IndexInfo{{Field: "email", Unique: true}}, s.ListIndexes(1))
+
+	alice, err := s.Insert(1, map[string]any{"email": "alice@example.com"})
+	require.NoError(t, err)
+	bob, err := s.Insert(1, map[string]any{"email": "bob@example.com"})
+	require.NoError(t, err)
+
+	_, err = s.Insert(1, map[string]any{"email": "alice@example.com"})
+	var uv ErrUniqueViolation
+	require.True(t, errors.As(err, &uv))
+	require.Equal(t, ErrUniqueViolation{Field: "email", Guid: alice}, uv)
+
+	err = s.Update(1, bob, map[string]any{"email": "alice@example.com"})
+	require.ErrorIs(t, err, ErrUniqueViolation{Field: "email", Guid: alice})
+	data, err := s.Get(1, bob)
+	require.NoError(t, err)
+	require.Equal(t, "bob@example.com", data["email"], "rejected update changes nothing")
+
+	require.NoError(t, s.Update(1, alice, map[string]any{"email": "alice@example.com", "name": "Alice"}),
+		"the record may keep its own value")
+
+	require.NoError(t, s.Update(1, alice, map[string]any{"email": "alice@example.org"}))
+	require.NoError(t, s.Update(1, bob, map[string]any{"email": "alice@example.com"}),
+		"older versions are exempt")
+
+	require.NoError(t, s.Remove(1, bob))
+	carol, err := s.Insert(1, map[string]any{"email": "alice@example.com"})
+	require.NoError(t, err, "removed records are exempt")
+
+	err = s.Restore(1, bob)
+	require.ErrorIs(t, err, ErrUniqueViolation{Field: "email", Guid: carol})
+
+	require.NoError(t, s.DropIndex(1, "email"))
+	_, err = s.Insert(1, map[string]any{"email": "alice@example.com"})
+	require.NoError(t, err)
+	require.True(t, errors.As(s.CreateUniqueIndex(1, "email"), &uv), "live records already have equal values")
+	require.Empty(t, s.ListIndexes(1))
+}
+
+func Test_stash_Unique_Tx(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.CreateUniqueIndex(1, "email"))
+
+	alice, err := s.Insert(1, map[string]any{"email": "alice@example.com"})
+	require.NoError(t, err)
+	bob, err := s.Insert(1, map[string]any{"email": "bob@example.com"})
+	require.NoError(t, err)
+
+	tx := s.Begin()
+	require.NoError(t, tx.Update(1, alice, map[string]any{"email": "bob@example.com"}))
+	require.NoError(t, tx.Update(1, bob, map[string]any{"email": "alice@example.com"}))
+	require.NoError(t, tx.Commit(), "values may be swapped in one transaction")
+
+	tx = s.Begin()
+	_, err = tx.Insert(1, map[string]any{"email": "carol@example.com"})
+	require.NoError(t, err)
+	_, err = tx.Insert(1, map[string]any{"email": "carol@example.com"})
+	require.NoError(t, err)
+	var uv ErrUniqueViolation
+	require.True(t, errors.As(tx.Commit(), &uv))
+
+	tx = s.Begin()
+	_, err = tx.Insert(1, map[string]any{"email": "dave@example.com"})
+	require.NoError(t, err)
+	_, err = tx.Insert(1, map[string]any{"email": "alice@example.com"})
+	require.NoError(t, err)
+	require.ErrorIs(t, tx.Commit(), ErrUniqueViolation{Field: "email", Guid: bob})
+
+	records, err := s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 2, "rejected transactions change nothing")
+}
+
+func Test_stash_Unique_expired(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.CreateUniqueIndex(1, "email"))
+
+	expired, err := s.Insert(1, map[string]any{"email": "a"}, WithTTL(10*time.Millisecond))
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = s.Get(1, expired)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	guid, err := s.Insert(1, map[string]any{"email": "a"})
+	require.NoError(t, err, "the expired record doesn't hold the value")
+	_, err = s.Insert(1, map[string]any{"email": "a"})
+	require.ErrorIs(t, err, ErrUniqueViolation{Field: "email", Guid: guid}, "the live holder is reported")
+
+	other, err := s.Insert(1, map[string]any{"email": "b"}, WithTTL(10*time.Millisecond))
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	tx := s.Begin()
+	_, err = tx.Insert(1, map[string]any{"email": "b"})
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(), "the expired record doesn't hold the value in the transaction")
+
+	removed, err := s.Reap()
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+	_, err = s.Get(1, other)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,286 @@
+package stashdb
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// passwordIterations the number of PBKDF2-HMAC-SHA256 iterations of new password hashes
+	passwordIterations = 100000
+	saltLength         = 16
+	tokenLength        = 32
+	defaultSessionTTL  = 12 * time.Hour
+	maxUserId          = FieldIdType(0xffff)
+)
+
+var (
+	ErrUserExists         = errors.New("user already exists")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidUser        = errors.New("invalid user")
+	ErrInvalidCredentials = errors.New("invalid user name or password")
+	ErrInvalidSession     = errors.New("invalid or expired session")
+)
+
+// userEntry the user stored in the system section, key (metadataSection, usersRecordId, user id)
+type userEntry struct {
+	name       string
+	salt       []byte
+	hash       []byte
+	iterations int
+	// grants holds roles by section, see Grant
+	grants map[SectionIdType]Role
+}
+
+// session the token issued by Login
+type session struct {
+	user    string
+	expires time.Time
+}
+
+// hashPassword derives the password hash with PBKDF2-HMAC-SHA256, one block is enough for the sha256 size
+func hashPassword(password string, salt []byte, iterations int) []byte {
+	mac := hmac.New(sha256.New, []byte(password))
+	mac.Write(salt)
+	mac.Write([]byte{0, 0, 0, 1})
+	u := mac.Sum(nil)
+	res := append([]byte(nil), u...)
+	for i := 1; i < iterations; i++ {
+		mac.Reset()
+		mac.Write(u)
+		u = mac.Sum(u[:0])
+		for j := range res {
+			res[j] ^= u[j]
+		}
+	}
+	return res
+}
+
+func newUserEntry(name, password string) (userEntry, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return userEntry{}, err
+	}
+	return userEntry{
+		name:       name,
+		salt:       salt,
+		hash:       hashPassword(password, salt, passwordIterations),
+		iterations: passwordIterations,
+	}, nil
+}
+
+func (u userEntry) check(password string) bool {
+	return hmac.Equal(u.hash, hashPassword(password, u.salt, u.iterations))
+}
+
+func userKey(id FieldIdType) Key {
+	return NewKey(metadataSection, usersRecordId, id)
+}
+
+// CreateUser adds the user with the password, the password is kept as the salted hash only
+func (s *Stash) CreateUser(name, password string) error {
+	if name == "" || password == "" {
+		return fmt.Errorf("%w: empty name or password", ErrInvalidUser)
+	}
+	u, err := newUserEntry(name, password)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[name]; ok {
+		return fmt.Errorf("%w: %s", ErrUserExists, name)
+	}
+	id, err := s.newUserId()
+	if err != nil {
+		return err
+	}
+	s.store(userKey(id), u)
+	s.users[name] = id
+
+	s.sugar.Debugw("user created", "user", name, "id", id)
+	return s.commit()
+}
+
+// DeleteUser removes the user and ends its sessions
+func (s *Stash) DeleteUser(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ok := s.users[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, name)
+	}
+	s.unstore(userKey(id))
+	delete(s.users, name)
+	s.dropSessions(name)
+
+	s.sugar.Debugw("user deleted", "user", name, "id", id)
+	return s.commit()
+}
+
+// SetPassword changes the password of the user and ends its sessions
+func (s *Stash) SetPassword(name, password string) error {
+	if password == "" {
+		return fmt.Errorf("%w: empty password", ErrInvalidUser)
+	}
+	u, err := newUserEntry(name, password)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, err := s.getUser(name)
+	if err != nil {
+		return err
+	}
+	u.grants = prev.grants
+	s.store(userKey(s.users[name]), u)
+	s.dropSessions(name)
+
+	s.sugar.Debugw("password changed", "user", name)
+	return s.commit()
+}
+
+// ListUsers returns sorted user names
+func (s *Stash) ListUsers() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.users))
+	for name := range s.users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasUser reports whether the user exists
+func (s *Stash) HasUser(name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.users[name]
+	return ok
+}
+
+// Login checks the password and returns the session token valid for the session ttl (see WithSessionTTL)
+func (s *Stash) Login(name, password string) (string, error) {
+	s.mu.RLock()
+	u, err := s.getUser(name)
+	s.mu.RUnlock()
+	if errors.Is(err, ErrUserNotFound) {
+		// spend the same time as for the wrong password
+		u = userEntry{iterations: passwordIterations}
+	} else if err != nil {
+		return "", err
+	}
+	if !u.check(password) || u.name == "" {
+		s.sugar.Infow("login failed", "user", name)
+		return "", ErrInvalidCredentials
+	}
+
+	b := make([]byte, tokenLength)
+	if _, err = rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+
+	s.sessions[token] = session{user: name, expires: time.Now().Add(s.sessionTTL)}
+	s.sugar.Infow("login", "user", name)
+	return token, nil
+}
+
+// Logout ends the session
+func (s *Stash) Logout(token string) {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+
+	delete(s.sessions, token)
+}
+
+// Session returns the user name of the session, ErrInvalidSession if the token is unknown or expired
+func (s *Stash) Session(token string) (string, error) {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+
+	sess, ok := s.sessions[token]
+	if !ok {
+		return "", ErrInvalidSession
+	}
+	if !time.Now().Before(sess.expires) {
+		delete(s.sessions, token)
+		return "", ErrInvalidSession
+	}
+	return sess.user, nil
+}
+
+// getUser reads the user entry
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) getUser(name string) (userEntry, error) {
+	id, ok := s.users[name]
+	if !ok {
+		return userEntry{}, fmt.Errorf("%w: %s", ErrUserNotFound, name)
+	}
+	value, ok := s.m.Load(userKey(id))
+	if !ok {
+		return userEntry{}, fmt.Errorf("%w: %s", ErrUserNotFound, name)
+	}
+	u, ok := value.(userEntry)
+	if !ok {
+		return userEntry{}, fmt.Errorf("stored value is not user (key %s)", userKey(id))
+	}
+	return u, nil
+}
+
+// newUserId returns the smallest free user id
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) newUserId() (FieldIdType, error) {
+	taken := make(map[FieldIdType]bool, len(s.users))
+	for _, id := range s.users {
+		taken[id] = true
+	}
+	for id := FieldIdType(1); id < maxUserId; id++ {
+		if !taken[id] {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: too many users", ErrInvalidUser)
+}
+
+// dropSessions ends all sessions of the user
+func (s *Stash) dropSessions(name string) {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+
+	for token, sess := range s.sessions {
+		if sess.user == name {
+			delete(s.sessions, token)
+		}
+	}
+}
+
+// loadUser restores the user from the system section
+func (s *Stash) loadUser(key Key, value any) error {
+	u, ok := value.(userEntry)
+	if !ok {
+		return fmt.Errorf("stored value is not user (key %s)", key)
+	}
+	s.users[u.name] = key.Field()
+	return nil
+}
@@ -0,0 +1,79 @@
+package stashdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Users(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, s.CreateUser("", "secret"), ErrInvalidUser)
+	require.NoError(t, s.CreateUser("bob", "secret"))
+	require.NoError(t, s.CreateUser("alice", "password"))
+	require.ErrorIs(t, s.CreateUser("bob", "other"), ErrUserExists)
+	require.Equal(t, []string{"alice", "bob"}, s.ListUsers())
+	require.True(t, s.HasUser("bob"))
+	require.False(t, s.HasUser("nobody"))
+
+	u, err := s.getUser("bob")
+	require.NoError(t, err)
+	require.NotContains(t, string(u.hash), "secret", "only the hash is stored")
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+	require.Equal(t, []string{"alice", "bob"}, s.ListUsers())
+
+	_, err = s.Login("bob", "wrong")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = s.Login("nobody", "secret")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	token, err := s.Login("bob", "secret")
+	require.NoError(t, err)
+	user, err := s.Session(token)
+	require.NoError(t, err)
+	require.Equal(t, "bob", user)
+
+	s.Logout(token)
+	_, err = s.Session(token)
+	require.ErrorIs(t, err, ErrInvalidSession)
+
+	token, err = s.Login("bob", "secret")
+	require.NoError(t, err)
+	require.NoError(t, s.SetPassword("bob", "changed"))
+	_, err = s.Session(token)
+	require.ErrorIs(t, err, ErrInvalidSession, "password change ends sessions")
+	_, err = s.Login("bob", "secret")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	token, err = s.Login("alice", "password")
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteUser("alice"))
+	require.ErrorIs(t, s.DeleteUser("alice"), ErrUserNotFound)
+	_, err = s.Session(token)
+	require.ErrorIs(t, err, ErrInvalidSession)
+	require.Equal(t, []string{"bob"}, s.ListUsers())
+	require.False(t, s.HasUser("alice"))
+}
+
+func Test_stash_SessionTTL(t *testing.T) {
+	s, err := NewStash(getTestLogger(), WithSessionTTL(10*time.Millisecond))
+	require.NoError(t, err)
+	require.NoError(t, s.CreateUser("bob", "secret"))
+
+	token, err := s.Login("bob", "secret")
+	require.NoError(t, err)
+	_, err = s.Session(token)
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+	_, err = s.Session(token)
+	require.ErrorIs(t, err, ErrInvalidSession)
+}
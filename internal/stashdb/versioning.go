@@ -0,0 +1,264 @@
+package stashdb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VersioningMode defines how many versions of the record the section keeps
+type VersioningMode byte
+
+const (
+	// KeepAllVersions every update adds the version, nothing is removed (default)
+	KeepAllVersions VersioningMode = iota
+	// KeepLastVersions only SectionConfig.MaxVersions newest versions are kept
+	KeepLastVersions
+	// NoHistory update overwrites the record in place
+	NoHistory
+)
+
+// the section settings are stored in the system section, record sectionsRecordId + section
+const (
+	sectionsRecordId RecordIdType = 0x100
+
+	versioningFieldId     FieldIdType = 1
+	maxVersionsFieldId    FieldIdType = 2
+	retentionFieldId      FieldIdType = 3
+	tombstoneGraceFieldId FieldIdType = 4
+	ttlFieldId            FieldIdType = 5
+)
+
+var ErrInvalidConfig = errors.New("invalid section config")
+
+// SectionConfig the section settings
+type SectionConfig struct {
+	Versioning  VersioningMode
+	MaxVersions uint64
+	// Retention the compactor drops versions replaced earlier than Retention ago, 0 - keep
+	Retention time.Duration
+	// TombstoneGrace the compactor purges records removed earlier than TombstoneGrace ago, 0 - keep
+	TombstoneGrace time.Duration
+	// TTL the default time to live of records written without the explicit TTL, 0 - forever
+	TTL time.Duration
+}
+
+// String is Stringer implementation
+func (m VersioningMode) String() string {
+	switch m {
+	case KeepAllVersions:
+		return "all"
+	case KeepLastVersions:
+		return "last"
+	case NoHistory:
+		return "none"
+	}
+	return "unknown"
+}
+
+func (c SectionConfig) validate() error {
+	if c.Retention < 0 || c.TombstoneGrace < 0 || c.TTL < 0 {
+		return fmt.Errorf("%w: negative duration", ErrInvalidConfig)
+	}
+
+	switch c.Versioning {
+	case KeepAllVersions, NoHistory:
+		return nil
+	case KeepLastVersions:
+		if c.MaxVersions == 0 {
+			return fmt.Errorf("%w: max versions must be > 0", ErrInvalidConfig)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unknown versioning %d", ErrInvalidConfig, c.Versioning)
+}
+
+// keepVersions returns the number of versions to keep, 0 - unlimited
+func (c SectionConfig) keepVersions() int {
+	switch c.Versioning {
+	case KeepLastVersions:
+		return int(c.MaxVersions)
+	case NoHistory:
+		return 1
+	}
+	return 0
+}
+
+func sectionConfigKey(section SectionIdType, field FieldIdType) Key {
+	return NewKey(metadataSection, sectionsRecordId+RecordIdType(section), field)
+}
+
+// SectionConfig returns the section settings
+func (s *Stash) SectionConfig(section SectionIdType) SectionConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sections[section]
+}
+
+// SetSectionConfig saves the section settings and drops the versions which are not kept anymore
+func (s *Stash) SetSectionConfig(section SectionIdType, cfg SectionConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store(sectionConfigKey(section, versioningFieldId), int64(cfg.Versioning))
+	s.store(sectionConfigKey(section, maxVersionsFieldId), int64(cfg.MaxVersions))
+	s.store(sectionConfigKey(section, retentionFieldId), int64(cfg.Retention))
+	s.store(sectionConfigKey(section, tombstoneGraceFieldId), int64(cfg.TombstoneGrace))
+	s.store(sectionConfigKey(section, ttlFieldId), int64(cfg.TTL))
+	s.sections[section] = cfg
+
+	if keep := cfg.keepVersions(); keep > 0 {
+		var versions, keys int
+		for _, registry := range []map[GUIDType]Key{s.records[section], s.tombstones[section]} {
+			for _, key := range registry {
+				v, k, err := s.prune(key, keep)
+				if err != nil {
+					return err
+				}
+				versions, keys = versions+v, keys+k
+			}
+		}
+		s.sugar.Debugw("versions pruned", "section", section, "versions", versions, "keys", keys)
+	}
+
+	return s.commit()
+}
+
+// loadSectionConfig restores the section setting from the system section
+func (s *Stash) loadSectionConfig(key Key, value any) error {
+	section := SectionIdType(key.Record() - sectionsRecordId)
+	v, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("section config is not int64 (key %s)", key)
+	}
+
+	cfg := s.sections[section]
+	switch key.Field() {
+	case versioningFieldId:
+		cfg.Versioning = VersioningMode(v)
+	case maxVersionsFieldId:
+		cfg.MaxVersions = uint64(v)
+	case retentionFieldId:
+		cfg.Retention = time.Duration(v)
+	case tombstoneGraceFieldId:
+		cfg.TombstoneGrace = time.Duration(v)
+	case ttlFieldId:
+		cfg.TTL = time.Duration(v)
+	}
+	s.sections[section] = cfg
+	return nil
+}
+
+// overwrite replaces fields of the record in place, used by NoHistory sections
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) overwrite(key Key, op OperationType, data map[string]any, w writeOptions) error {
+	section := key.Section()
+	header, err := s.getRecordHeader(key)
+	if err != nil {
+		return err
+	}
+
+	for _, fieldKey := range s.recordKeys(key) {
+		if fieldKey.Field() != headerFieldId {
+			s.unstore(fieldKey)
+		}
+	}
+
+	header.operation = op
+	header.time = time.Now()
+	header.revision++
+	header.deleted = false
+	header.removed = time.Time{}
+	header.seq = s.nextSeq()
+	header.removedSeq = 0
+	header.user = w.user
+	header.removedBy = ""
+	header.expires = s.nextExpiresAt(section, w, header.expires)
+	s.store(key, header)
+	s.putData(section, key.Record(), data)
+	s.recordAddSFG(section, header.guid, key)
+	s.indexPut(section, header.guid, data)
+	s.addEvent(section, header.guid, op, data)
+
+	s.sugar.Debugw("overwrite", "operation", op, "guid", header.guid, "key", key)
+	return nil
+}
+
+// prune keeps only keep newest versions of the record with the head version key,
+// returns the numbers of dropped versions and released keys
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) prune(key Key, keep int) (int, int, error) {
+	section := key.Section()
+	header, err := s.getRecordHeader(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := 1; i < keep && header.prev != 0; i++ {
+		key = NewKey(section, header.prev, headerFieldId)
+		if header, err = s.getRecordHeader(key); err != nil {
+			return 0, 0, err
+		}
+	}
+	return s.cut(key, header)
+}
+
+// cut drops all versions older than the version with the header key
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) cut(key Key, header recordHeader) (int, int, error) {
+	if header.prev == 0 {
+		return 0, 0, nil
+	}
+
+	prev := header.prev
+	header.prev = 0
+	s.store(key, header)
+
+	return s.dropVersions(NewKey(key.Section(), prev, headerFieldId))
+}
+
+// dropVersions removes the version with the header key and all older versions,
+// returns the numbers of dropped versions and released keys
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) dropVersions(key Key) (int, int, error) {
+	var versions, keys int
+	for {
+		header, err := s.getRecordHeader(key)
+		if err != nil {
+			return versions, keys, err
+		}
+		for _, k := range s.recordKeys(key) {
+			s.unstore(k)
+			keys++
+		}
+		versions++
+		if header.prev == 0 {
+			return versions, keys, nil
+		}
+		key = NewKey(key.Section(), header.prev, headerFieldId)
+	}
+}
+
+// recordKeys returns the header and field keys of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) recordKeys(key Key) []Key {
+	var keys []Key
+	section, recId := key.Section(), key.Record()
+	it := s.iteratorAt(s.ceiling(NewKey(section, recId, headerFieldId)))
+	for ; it.pos == onmyway; it.next() {
+		if it.node.key.Section() != section || it.node.key.Record() != recId {
+			break
+		}
+		keys = append(keys, it.node.key)
+	}
+	return keys
+}
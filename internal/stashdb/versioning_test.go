@@ -0,0 +1,81 @@
+package stashdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_KeepLastVersions(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	guid, err := s.Insert(1, map[string]any{"int_val": 0})
+	require.NoError(t, err)
+	for i := 1; i < 5; i++ {
+		require.NoError(t, s.Update(1, guid, map[string]any{"int_val": i}))
+	}
+	size := s.sizeof()
+
+	require.ErrorIs(t, s.SetSectionConfig(1, SectionConfig{Versioning: KeepLastVersions}), ErrInvalidConfig)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{Versioning: KeepLastVersions, MaxVersions: 2}))
+	require.EqualValues(t, size-3*2+5, s.sizeof(), "3 old versions must be released, 5 config keys added")
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.EqualValues(t, 4, versions[0].Revision)
+
+	require.NoError(t, s.Update(1, guid, map[string]any{"int_val": 5}))
+	versions, err = s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.EqualValues(t, map[string]any{"int_val": 4}, versions[0].Data)
+	require.EqualValues(t, map[string]any{"int_val": 5}, versions[1].Data)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.Equal(t, SectionConfig{Versioning: KeepLastVersions, MaxVersions: 2}, s.SectionConfig(1))
+	require.Equal(t, SectionConfig{}, s.SectionConfig(2))
+
+	versions, err = s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+}
+
+func Test_stash_NoHistory(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{Versioning: NoHistory}))
+
+	guid, err := s.Insert(1, map[string]any{"tag": "#tag", "int_val": 0})
+	require.NoError(t, err)
+	key, err := s.recordKeySFG(1, guid)
+	require.NoError(t, err)
+	size := s.sizeof()
+
+	for i := 1; i < 5; i++ {
+		require.NoError(t, s.Update(1, guid, map[string]any{"int_val": i}))
+	}
+	require.EqualValues(t, size-1, s.sizeof(), "old keys must be released")
+
+	from, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"int_val": 4}, from)
+
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.EqualValues(t, 5, versions[0].Revision)
+	require.EqualValues(t, key.Record(), versions[0].RecordId, "record must be updated in place")
+
+	require.NoError(t, s.Remove(1, guid))
+	require.NoError(t, s.Restore(1, guid))
+	from, err = s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"int_val": 4}, from)
+}
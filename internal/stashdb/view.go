@@ -0,0 +1,235 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+)
+
+var ErrSnapshotTooOld = errors.New("version of the snapshot was pruned")
+
+// View the read-only view of the Stash pinned to the sequence number of the last commit
+// at the moment it was opened. Reads of the view don't see later writes, writers are not blocked by it.
+//
+// Versions are kept for open views by the compactor, but sections with KeepLastVersions and NoHistory
+// drop old versions on update, reads of such records return ErrSnapshotTooOld.
+type View struct {
+	stash *Stash
+	seq   uint64
+	once  sync.Once
+}
+
+// Snapshot opens the read view, it must be closed to let the compactor drop the versions it pins
+func (s *Stash) Snapshot() *View {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v := &View{stash: s, seq: s.seq}
+
+	s.viewsMu.Lock()
+	s.views[v] = struct{}{}
+	s.viewsMu.Unlock()
+
+	s.sugar.Debugw("view opened", "seq", v.seq)
+	return v
+}
+
+// oldestView returns the sequence number of the oldest open view, math.MaxUint64 if there are none
+func (s *Stash) oldestView() uint64 {
+	s.viewsMu.Lock()
+	defer s.viewsMu.Unlock()
+
+	oldest := uint64(math.MaxUint64)
+	for v := range s.views {
+		if v.seq < oldest {
+			oldest = v.seq
+		}
+	}
+	return oldest
+}
+
+// Seq returns the sequence number the view is pinned to
+func (v *View) Seq() uint64 {
+	return v.seq
+}
+
+// Close releases the view
+func (v *View) Close() {
+	v.once.Do(func() {
+		v.stash.viewsMu.Lock()
+		delete(v.stash.views, v)
+		v.stash.viewsMu.Unlock()
+	})
+}
+
+// Get returns the record as it was at the view sequence number
+func (v *View) Get(section SectionIdType, guid GUIDType) (map[string]any, error) {
+	s := v.stash
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.lastRecordKey(section, guid)
+	if err != nil {
+		return nil, err
+	}
+	if key, err = v.visibleKey(key); err != nil {
+		return nil, err
+	}
+	s.sugar.Debugw("view get", "guid", guid, "seq", v.seq, "key", key)
+
+	return s.getData(key)
+}
+
+// Find returns records which were live at the view sequence number and accepted by f,
+// f works as in Stash.Find
+func (v *View) Find(ctx context.Context, section SectionIdType, f func(*map[string]any) (bool, bool)) ([]Record, error) {
+	s := v.stash
+
+	// removed records are candidates too, they could be live at the view sequence number
+	s.mu.RLock()
+	guids := make([]GUIDType, 0, len(s.records[section])+len(s.tombstones[section]))
+	for guid := range s.records[section] {
+		guids = append(guids, guid)
+	}
+	for guid := range s.tombstones[section] {
+		guids = append(guids, guid)
+	}
+	s.mu.RUnlock()
+
+	var founded []Record
+	for _, guid := range guids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := v.Get(section, guid)
+		if errors.Is(err, ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ok, stop := true, false
+		if f != nil {
+			ok, stop = f(&data)
+		}
+
+		if ok {
+			founded = append(founded, Record{
+				guid: guid,
+				data: data,
+			})
+		}
+
+		if stop {
+			break
+		}
+	}
+	return founded, nil
+}
+
+// History returns versions of the record written up to the view sequence number
+// from the oldest to the newest
+func (v *View) History(section SectionIdType, guid GUIDType) ([]Version, error) {
+	s := v.stash
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, err := s.lastRecordKey(section, guid)
+	if err != nil {
+		return nil, err
+	}
+	if key, err = v.writtenKey(key); err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	deleted := false
+	for {
+		var header recordHeader
+		header, err = s.getRecordHeader(key)
+		if err != nil {
+			return nil, err
+		}
+
+		var data map[string]any
+		data, err = s.getData(key)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, Version{
+			Revision:  header.revision,
+			RecordId:  key.Record(),
+			Operation: header.operation,
+			User:      header.user,
+			Time:      header.time,
+			Deleted:   deleted || v.removed(header),
+			Data:      data,
+		})
+		deleted = true
+
+		if header.prev == 0 {
+			break
+		}
+		key = NewKey(section, header.prev, headerFieldId)
+	}
+
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	return versions, nil
+}
+
+// visibleKey returns the header key of the version which was live at the view sequence number,
+// key is the header key of the newest version of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (v *View) visibleKey(key Key) (Key, error) {
+	key, err := v.writtenKey(key)
+	if err != nil {
+		return key, err
+	}
+
+	header, err := v.stash.getRecordHeader(key)
+	if err != nil {
+		return key, err
+	}
+	if v.removed(header) {
+		return key, ErrRecordNotFound
+	}
+	return key, nil
+}
+
+// writtenKey returns the header key of the newest version written up to the view sequence number,
+// key is the header key of the newest version of the record
+//
+// IMPORTANT: must be called under s.mu lock
+func (v *View) writtenKey(key Key) (Key, error) {
+	s := v.stash
+	header, err := s.getRecordHeader(key)
+	if err != nil {
+		return key, err
+	}
+
+	for header.seq > v.seq {
+		if header.prev == 0 {
+			if header.revision > 1 {
+				return key, ErrSnapshotTooOld
+			}
+			return key, ErrRecordNotFound
+		}
+		key = NewKey(key.Section(), header.prev, headerFieldId)
+		if header, err = s.getRecordHeader(key); err != nil {
+			return key, err
+		}
+	}
+	return key, nil
+}
+
+// removed reports if the version was removed up to the view sequence number
+func (v *View) removed(header recordHeader) bool {
+	return header.removedSeq != 0 && header.removedSeq <= v.seq
+}
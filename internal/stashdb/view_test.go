@@ -0,0 +1,125 @@
+package stashdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stash_Snapshot(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	v1 := map[string]any{"tag": "#tag1"}
+	v2 := map[string]any{"tag": "#tag2"}
+
+	updated, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	removed, err := s.Insert(1, v1)
+	require.NoError(t, err)
+
+	view := s.Snapshot()
+	defer view.Close()
+
+	require.NoError(t, s.Update(1, updated, v2))
+	require.NoError(t, s.Remove(1, removed))
+	inserted, err := s.Insert(1, v2)
+	require.NoError(t, err)
+
+	data, err := view.Get(1, updated)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, data, "view doesn't see later updates")
+
+	data, err = view.Get(1, removed)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, data, "view doesn't see later removals")
+
+	_, err = view.Get(1, inserted)
+	require.ErrorIs(t, err, ErrRecordNotFound, "view doesn't see later inserts")
+
+	records, err := view.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, rec := range records {
+		require.Contains(t, []GUIDType{updated, removed}, rec.guid)
+		require.EqualValues(t, v1, rec.data)
+	}
+
+	versions, err := view.History(1, updated)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.False(t, versions[0].Deleted)
+
+	versions, err = s.History(1, updated)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+
+	later := s.Snapshot()
+	defer later.Close()
+	require.True(t, later.Seq() > view.Seq())
+
+	_, err = later.Get(1, removed)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	versions, err = later.History(1, removed)
+	require.NoError(t, err)
+	require.True(t, versions[0].Deleted)
+
+	data, err = later.Get(1, inserted)
+	require.NoError(t, err)
+	require.EqualValues(t, v2, data)
+}
+
+func Test_stash_Snapshot_Pruned(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{Versioning: NoHistory}))
+
+	guid, err := s.Insert(1, map[string]any{"tag": "#tag1"})
+	require.NoError(t, err)
+
+	view := s.Snapshot()
+	defer view.Close()
+
+	require.NoError(t, s.Update(1, guid, map[string]any{"tag": "#tag2"}))
+
+	_, err = view.Get(1, guid)
+	require.ErrorIs(t, err, ErrSnapshotTooOld)
+}
+
+func Test_stash_Snapshot_Compact(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+	require.NoError(t, s.SetSectionConfig(1, SectionConfig{Retention: time.Nanosecond, TombstoneGrace: time.Nanosecond}))
+
+	v1 := map[string]any{"tag": "#tag1"}
+	updated, err := s.Insert(1, v1)
+	require.NoError(t, err)
+	removed, err := s.Insert(1, v1)
+	require.NoError(t, err)
+
+	view := s.Snapshot()
+
+	require.NoError(t, s.Update(1, updated, map[string]any{"tag": "#tag2"}))
+	require.NoError(t, s.Remove(1, removed))
+	time.Sleep(time.Millisecond)
+
+	stats, err := s.Compact()
+	require.NoError(t, err)
+	require.Zero(t, stats.Versions, "versions of the open view are kept")
+	require.Zero(t, stats.Tombstones)
+
+	data, err := view.Get(1, updated)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, data)
+	data, err = view.Get(1, removed)
+	require.NoError(t, err)
+	require.EqualValues(t, v1, data)
+
+	view.Close()
+	stats, err = s.Compact()
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Versions)
+	require.Equal(t, 1, stats.Tombstones)
+}
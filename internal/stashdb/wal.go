@@ -0,0 +1,464 @@
+package stashdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SyncPolicy defines when the write-ahead log is flushed to the disk
+type SyncPolicy byte
+
+const (
+	// SyncAlways fsync after every write, the slowest and the safest
+	SyncAlways SyncPolicy = iota
+	// SyncInterval fsync in background every sync interval
+	SyncInterval
+	// SyncOS fsync is never called, flushing is managed by the OS
+	SyncOS
+
+	walExt          = ".wal"
+	walFrameHeader  = 8
+	walDefaultEvery = 100 * time.Millisecond
+)
+
+var (
+	ErrWALCorrupted = errors.New("wal corrupted")
+	// ErrWALFailed the log could not be restored after the failed write, further writes are rejected
+	ErrWALFailed = errors.New("wal failed")
+)
+
+// ParseSyncPolicy converts "always", "interval" or "os" to SyncPolicy
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch strings.ToLower(s) {
+	case "always":
+		return SyncAlways, nil
+	case "interval":
+		return SyncInterval, nil
+	case "os":
+		return SyncOS, nil
+	}
+	return SyncAlways, fmt.Errorf("unknown sync policy %q", s)
+}
+
+// String is Stringer implementation
+func (p SyncPolicy) String() string {
+	switch p {
+	case SyncAlways:
+		return "always"
+	case SyncInterval:
+		return "interval"
+	case SyncOS:
+		return "os"
+	}
+	return "unknown"
+}
+
+// walMutation the single key change
+type walMutation struct {
+	key     Key
+	value   any
+	removed bool
+}
+
+// wal the write-ahead log.
+//
+// Every frame holds all mutations of one Stash operation:
+//
+// [0:4] payload length uint32
+//
+// [4:8] payload crc32
+//
+// payload: lsn uint64, mutations count uvarint, mutations
+type wal struct {
+	dir      string
+	policy   SyncPolicy
+	interval time.Duration
+
+	mu    sync.Mutex
+	file  walFile
+	size  int64 // the size of the current segment, appended frames end there
+	lsn   uint64
+	start uint64 // frames up to start are covered by the snapshot
+	dirty bool
+	// failed the error which left the log in unknown state, appends are rejected
+	failed error
+
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	sugar *zap.SugaredLogger
+}
+
+// walFile the open segment, *os.File
+type walFile interface {
+	io.Writer
+	Sync() error
+	Close() error
+	Truncate(size int64) error
+	Name() string
+}
+
+// openSegment opens the segment for appending and returns its size
+func openSegment(path string) (*os.File, int64, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+func openWAL(dir string, policy SyncPolicy, interval time.Duration, sugar *zap.SugaredLogger) (*wal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		interval = walDefaultEvery
+	}
+
+	return &wal{
+		dir:      dir,
+		policy:   policy,
+		interval: interval,
+		done:     make(chan struct{}),
+		sugar:    sugar,
+	}, nil
+}
+
+// segments returns the log files sorted by the first lsn
+func (w *wal) segments() ([]string, error) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != walExt {
+			continue
+		}
+		if _, err := segmentLSN(e.Name()); err != nil {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func segmentName(lsn uint64) string {
+	return fmt.Sprintf("%016x%s", lsn, walExt)
+}
+
+func segmentLSN(name string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimSuffix(name, walExt), 16, 64)
+}
+
+// replay reads all segments and calls apply for every mutation, then opens the log for appending.
+// The broken tail of the last segment (e.g. after a crash) is truncated.
+func (w *wal) replay(apply func(walMutation)) error {
+	names, err := w.segments()
+	if err != nil {
+		return err
+	}
+
+	for i, name := range names {
+		path := filepath.Join(w.dir, name)
+		var good int64
+		good, err = w.replayFile(path, apply)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, ErrWALCorrupted) || i != len(names)-1 {
+			return fmt.Errorf("replay %s: %w", name, err)
+		}
+		w.sugar.Warnw("wal broken tail truncated", "segment", name, "offset", good, "err", err)
+		if err = os.Truncate(path, good); err != nil {
+			return err
+		}
+	}
+
+	name := segmentName(w.lsn + 1)
+	if len(names) > 0 {
+		name = names[len(names)-1]
+	}
+	if w.file, w.size, err = openSegment(filepath.Join(w.dir, name)); err != nil {
+		return err
+	}
+
+	if w.policy == SyncInterval {
+		w.wg.Add(1)
+		go w.syncLoop()
+	}
+	return nil
+}
+
+func (w *wal) replayFile(path string, apply func(walMutation)) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var offset int64
+	for int(offset) < len(data) {
+		rest := data[offset:]
+		if len(rest) < walFrameHeader {
+			return offset, ErrWALCorrupted
+		}
+		l := binary.BigEndian.Uint32(rest[0:4])
+		sum := binary.BigEndian.Uint32(rest[4:8])
+		if uint64(len(rest)-walFrameHeader) < uint64(l) {
+			return offset, ErrWALCorrupted
+		}
+		payload := rest[walFrameHeader : walFrameHeader+int(l)]
+		if crc32.ChecksumIEEE(payload) != sum {
+			return offset, ErrWALCorrupted
+		}
+
+		var lsn uint64
+		var muts []walMutation
+		lsn, muts, err = decodeFrame(payload)
+		if err != nil {
+			return offset, fmt.Errorf("%w: %v", ErrWALCorrupted, err)
+		}
+		offset += walFrameHeader + int64(l)
+		if lsn <= w.start {
+			continue
+		}
+		for _, m := range muts {
+			apply(m)
+		}
+		w.lsn = lsn
+	}
+	return offset, nil
+}
+
+// append writes mutations as one frame. The torn frame of the failed write is cut off, so the frames
+// appended after it are replayed. If it can't be cut off or fsync fails, the log is failed.
+func (w *wal) append(muts []walMutation) error {
+	if len(muts) == 0 {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.failed != nil {
+		return fmt.Errorf("%w: %v", ErrWALFailed, w.failed)
+	}
+
+	payload, err := encodeFrame(w.lsn+1, muts)
+	if err != nil {
+		return err
+	}
+
+	frame := make([]byte, walFrameHeader+len(payload))
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(frame[4:8], crc32.ChecksumIEEE(payload))
+	copy(frame[walFrameHeader:], payload)
+
+	if _, err = w.file.Write(frame); err != nil {
+		w.cut(err)
+		return err
+	}
+
+	if w.policy == SyncAlways {
+		if err = w.file.Sync(); err != nil {
+			// the page cache state is unknown after the failed fsync, retrying it may report success
+			w.cut(err)
+			w.failed = err
+			return err
+		}
+	} else {
+		w.dirty = true
+	}
+	w.lsn++
+	w.size += int64(len(frame))
+	return nil
+}
+
+// cut truncates the segment to the end of the last appended frame after the failed write
+//
+// IMPORTANT: must be called under w.mu lock
+func (w *wal) cut(cause error) {
+	w.sugar.Errorw("wal write failed", "segment", w.file.Name(), "size", w.size, "err", cause)
+	if err := w.file.Truncate(w.size); err != nil {
+		w.sugar.Errorw("wal truncate", "segment", w.file.Name(), "err", err)
+		w.failed = cause
+	}
+}
+
+// rotate closes the current segment and starts the new one, returns the last written lsn
+func (w *wal) rotate() (uint64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	name := segmentName(w.lsn + 1)
+	if filepath.Base(w.file.Name()) == name {
+		return w.lsn, nil
+	}
+
+	if err := w.file.Sync(); err != nil {
+		return 0, err
+	}
+	if err := w.file.Close(); err != nil {
+		return 0, err
+	}
+
+	var err error
+	if w.file, w.size, err = openSegment(filepath.Join(w.dir, name)); err != nil {
+		return 0, err
+	}
+	w.dirty = false
+	return w.lsn, nil
+}
+
+// truncate removes segments which contain frames up to lsn only
+func (w *wal) truncate(lsn uint64) error {
+	names, err := w.segments()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(names)-1; i++ {
+		var next uint64
+		next, err = segmentLSN(names[i+1])
+		if err != nil {
+			return err
+		}
+		if next > lsn+1 {
+			break
+		}
+		if err = os.Remove(filepath.Join(w.dir, names[i])); err != nil {
+			return err
+		}
+		w.sugar.Debugw("wal segment removed", "segment", names[i])
+	}
+	return nil
+}
+
+func (w *wal) syncLoop() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.sync(); err != nil {
+				w.sugar.Errorw("wal sync", "err", err)
+			}
+		}
+	}
+}
+
+func (w *wal) sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	if err := w.file.Sync(); err != nil {
+		// acknowledged frames may be lost, see append
+		w.failed = err
+		return err
+	}
+	return nil
+}
+
+// close flushes and closes the log
+func (w *wal) close() error {
+	close(w.done)
+	w.wg.Wait()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Sync()
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	w.file = nil
+	return err
+}
+
+func encodeFrame(lsn uint64, muts []walMutation) ([]byte, error) {
+	var buf bytes.Buffer
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], lsn)
+	buf.Write(b[:])
+	putUvarint(&buf, uint64(len(muts)))
+
+	for _, m := range muts {
+		putBool(&buf, m.removed)
+		buf.Write(m.key[:])
+		if m.removed {
+			continue
+		}
+		if err := encodeValue(&buf, m.value); err != nil {
+			return nil, fmt.Errorf("key %s: %w", m.key, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeFrame(payload []byte) (uint64, []walMutation, error) {
+	r := bytes.NewReader(payload)
+
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, nil, err
+	}
+	lsn := binary.BigEndian.Uint64(b[:])
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	muts := make([]walMutation, 0, n)
+	for i := uint64(0); i < n; i++ {
+		var m walMutation
+		if m.removed, err = getBool(r); err != nil {
+			return 0, nil, err
+		}
+		if _, err = io.ReadFull(r, m.key[:]); err != nil {
+			return 0, nil, err
+		}
+		if !m.removed {
+			if m.value, err = decodeValue(r); err != nil {
+				return 0, nil, err
+			}
+		}
+		muts = append(muts, m)
+	}
+	return lsn, muts, nil
+}
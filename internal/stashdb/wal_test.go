@@ -0,0 +1,228 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_wal_Replay(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+
+	to := map[string]any{
+		"tag":     "#tag1",
+		"text":    "sample text",
+		"int_val": int64(100),
+	}
+	guid1, err := s.Insert(1, to)
+	require.NoError(t, err)
+	guid2, err := s.Insert(1, to)
+	require.NoError(t, err)
+	guid3, err := s.Insert(2, to)
+	require.NoError(t, err)
+
+	to2 := map[string]any{
+		"text": "updated text",
+		"flag": true,
+	}
+	require.NoError(t, s.Update(1, guid1, to2))
+	require.NoError(t, s.Remove(1, guid2))
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	from, err := s.Get(1, guid1)
+	require.NoError(t, err)
+	require.EqualValues(t, to2, from)
+
+	_, err = s.Get(1, guid2)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	from, err = s.Get(2, guid3)
+	require.NoError(t, err)
+	require.EqualValues(t, to, from)
+
+	guid4, err := s.Insert(1, to)
+	require.NoError(t, err)
+	key, err := s.recordKeySFG(1, guid4)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, key.Record(), "counter must survive restart")
+}
+
+func Test_wal_BrokenTail(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncInterval, time.Millisecond))
+	require.NoError(t, err)
+	guid, err := s.Insert(1, map[string]any{"tag": "#tag1"})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	names, err := filepath.Glob(filepath.Join(dir, "*"+walExt))
+	require.NoError(t, err)
+	require.Len(t, names, 1)
+
+	f, err := os.OpenFile(names[0], os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 100, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir), WithSyncPolicy(SyncOS, 0))
+	require.NoError(t, err)
+
+	from, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]any{"tag": "#tag1"}, from)
+
+	_, err = s.Insert(1, map[string]any{"tag": "#tag2"})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	records, err := s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+}
+
+// faultyFile writes the half of the frame and fails once when short is set, fails fsync when failSync is set
+type faultyFile struct {
+	walFile
+	short    bool
+	failSync bool
+}
+
+func (f *faultyFile) Write(b []byte) (int, error) {
+	if f.short {
+		f.short = false
+		n, _ := f.walFile.Write(b[:len(b)/2])
+		return n, errors.New("no space left on device")
+	}
+	return f.walFile.Write(b)
+}
+
+func (f *faultyFile) Sync() error {
+	if f.failSync {
+		return errors.New("input/output error")
+	}
+	return f.walFile.Sync()
+}
+
+func Test_wal_ShortWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	before, err := s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+
+	faulty := &faultyFile{walFile: s.wal.file, short: true}
+	s.wal.mu.Lock()
+	s.wal.file = faulty
+	s.wal.mu.Unlock()
+
+	_, err = s.Insert(1, map[string]any{"n": int64(2)})
+	require.Error(t, err)
+	after, err := s.Insert(1, map[string]any{"n": int64(3)})
+	require.NoError(t, err, "the log keeps working after the torn frame is cut off")
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+
+	records, err := s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{before, after}, guidsOf(records), "writes acknowledged after the failure survive restart")
+}
+
+func Test_wal_FailedSync(t *testing.T) {
+	s, err := NewStash(getTestLogger(), WithDataDir(t.TempDir()))
+	require.NoError(t, err)
+	defer s.Close()
+
+	faulty := &faultyFile{walFile: s.wal.file, failSync: true}
+	s.wal.mu.Lock()
+	s.wal.file = faulty
+	s.wal.mu.Unlock()
+
+	_, err = s.Insert(1, map[string]any{"n": int64(1)})
+	require.Error(t, err)
+	faulty.failSync = false
+	_, err = s.Insert(1, map[string]any{"n": int64(2)})
+	require.ErrorIs(t, err, ErrWALFailed, "writes are rejected after the failed fsync")
+}
+
+// currentSeq returns the sequence number of the last commit
+func currentSeq(s *Stash) uint64 {
+	v := s.Snapshot()
+	defer v.Close()
+	return v.Seq()
+}
+
+func Test_wal_Rollback(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	require.NoError(t, s.CreateUniqueIndex(1, "email"))
+	guid, err := s.Insert(1, map[string]any{"email": "a", "n": int64(1)})
+	require.NoError(t, err)
+	seq := currentSeq(s)
+
+	faulty := &faultyFile{walFile: s.wal.file}
+	s.wal.mu.Lock()
+	s.wal.file = faulty
+	s.wal.mu.Unlock()
+
+	faulty.short = true
+	_, err = s.Insert(1, map[string]any{"email": "b", "extra": true})
+	require.Error(t, err)
+	faulty.short = true
+	require.Error(t, s.Update(1, guid, map[string]any{"email": "c", "n": int64(2)}))
+	faulty.short = true
+	require.Error(t, s.Remove(1, guid))
+
+	require.Equal(t, seq, currentSeq(s), "rejected writes don't take sequence numbers")
+	data, err := s.Get(1, guid)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"email": "a", "n": int64(1)}, data)
+	versions, err := s.History(1, guid)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	records, err := s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []GUIDType{guid}, guidsOf(records))
+	records, err = s.Lookup(1, "email", "c")
+	require.NoError(t, err)
+	require.Empty(t, records, "the index is rolled back")
+
+	other, err := s.Insert(1, map[string]any{"email": "b", "n": int64(3)})
+	require.NoError(t, err, "the value of the rejected insert is free")
+	events := watchN(t, s, WatchOptions{Section: 1, From: seq}, 2)
+	require.Equal(t, guid, events[0].Guid)
+	require.Equal(t, other, events[1].Guid, "rejected writes are not published")
+	require.Equal(t, seq+1, events[1].Seq)
+	require.NoError(t, s.Close())
+
+	s, err = NewStash(getTestLogger(), WithDataDir(dir))
+	require.NoError(t, err)
+	defer s.Close()
+	records, err = s.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []GUIDType{guid, other}, guidsOf(records))
+	require.Equal(t, seq+1, currentSeq(s))
+}
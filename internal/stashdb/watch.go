@@ -0,0 +1,165 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// feedCapacity the number of the latest events kept to resume watchers
+const feedCapacity = 4096
+
+var ErrWatchTooOld = errors.New("events to resume from are not kept anymore")
+
+// Event the change of the record
+type Event struct {
+	// Seq the sequence number of the commit, events of one transaction have the same Seq
+	Seq       uint64
+	Section   SectionIdType
+	Guid      GUIDType
+	Operation OperationType
+	// Data the new field values, the last values for RemoveOperation
+	Data map[string]any
+	Time time.Time
+}
+
+// WatchOptions selects events for Watch
+type WatchOptions struct {
+	Section SectionIdType
+	// Guid the record to watch, empty - all records of the section
+	Guid GUIDType
+	// Filter the filter expression for Data, see Filter
+	Filter string
+	// From the sequence number of the first event, 0 - from the next commit.
+	// To resume pass the sequence number of the last received event + 1.
+	From uint64
+}
+
+// feed keeps the latest events and wakes up watchers
+type feed struct {
+	mu     sync.Mutex
+	events []Event
+	// last the sequence number of the last published commit
+	last uint64
+	// dropped events up to this sequence number are not kept
+	dropped uint64
+	// notify is closed on publish
+	notify chan struct{}
+}
+
+func newFeed() *feed {
+	return &feed{notify: make(chan struct{})}
+}
+
+// reset starts the feed at the sequence number, earlier events are not available
+func (f *feed) reset(seq uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.last, f.dropped = seq, seq
+	f.events = nil
+}
+
+// publish adds events of the commit and wakes up watchers
+func (f *feed) publish(seq uint64, events []Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.last = seq
+	f.events = append(f.events, events...)
+	if len(f.events) > 2*feedCapacity {
+		f.dropped = f.events[len(f.events)-feedCapacity-1].Seq
+		f.events = append([]Event(nil), f.events[len(f.events)-feedCapacity:]...)
+	}
+	close(f.notify)
+	f.notify = make(chan struct{})
+}
+
+// since returns kept events with sequence numbers from seq and the channel to wait for new ones
+func (f *feed) since(seq uint64) ([]Event, <-chan struct{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if seq <= f.dropped {
+		return nil, nil, ErrWatchTooOld
+	}
+	i := sort.Search(len(f.events), func(i int) bool { return f.events[i].Seq >= seq })
+	return append([]Event(nil), f.events[i:]...), f.notify, nil
+}
+
+func (f *feed) next() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.last + 1
+}
+
+// Watch calls fn for every event matching opts in the commit order until ctx is done or fn returns an error,
+// ErrClosed when the Stash is closed. Slow watchers don't block writers, a watcher which falls behind
+// the kept events gets ErrWatchTooOld, so no event is missed silently.
+func (s *Stash) Watch(ctx context.Context, opts WatchOptions, fn func(Event) error) error {
+	filter, err := ParseFilter(opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	next := opts.From
+	if next == 0 {
+		next = s.feed.next()
+	}
+	s.sugar.Debugw("watch", "section", opts.Section, "guid", opts.Guid, "filter", filter, "from", next)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		select {
+		case <-s.done:
+			return ErrClosed
+		default:
+		}
+
+		events, notify, err := s.feed.since(next)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			next = e.Seq + 1
+			if e.Section != opts.Section || opts.Guid != "" && e.Guid != opts.Guid || !filter.Match(e.Data) {
+				continue
+			}
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-s.done:
+				return ErrClosed
+			case <-notify:
+			}
+		}
+	}
+}
+
+// addEvent remembers the change of the record, it is published by commit
+//
+// IMPORTANT: must be called under s.mu lock
+func (s *Stash) addEvent(section SectionIdType, guid GUIDType, op OperationType, data map[string]any) {
+	copied := make(map[string]any, len(data))
+	for name, value := range data {
+		copied[name] = value
+	}
+	s.events = append(s.events, Event{
+		Section:   section,
+		Guid:      guid,
+		Operation: op,
+		Data:      copied,
+		Time:      time.Now(),
+	})
+}
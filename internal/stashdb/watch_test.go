@@ -0,0 +1,129 @@
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errStopWatch = errors.New("stop watch")
+
+// watchN returns the first n events matching opts
+func watchN(t *testing.T, s *Stash, opts WatchOptions, n int) []Event {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var events []Event
+	err := s.Watch(ctx, opts, func(e Event) error {
+		events = append(events, e)
+		if len(events) == n {
+			return errStopWatch
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStopWatch)
+	return events
+}
+
+func Test_stash_Watch(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	guid, err := s.Insert(1, map[string]any{"name": "a", "n": int64(1)})
+	require.NoError(t, err)
+	other, err := s.Insert(1, map[string]any{"name": "b", "n": int64(5)})
+	require.NoError(t, err)
+	_, err = s.Insert(2, map[string]any{"name": "c"})
+	require.NoError(t, err)
+	require.NoError(t, s.Update(1, guid, map[string]any{"name": "a", "n": int64(2)}))
+	require.NoError(t, s.Remove(1, guid))
+
+	events := watchN(t, s, WatchOptions{Section: 1, From: 1}, 4)
+	require.Equal(t, []OperationType{InsertOperation, InsertOperation, UpdateOperation, RemoveOperation},
+		[]OperationType{events[0].Operation, events[1].Operation, events[2].Operation, events[3].Operation})
+	require.Equal(t, []uint64{1, 2, 4, 5}, []uint64{events[0].Seq, events[1].Seq, events[2].Seq, events[3].Seq})
+	require.Equal(t, map[string]any{"name": "a", "n": int64(2)}, events[2].Data)
+	require.Equal(t, map[string]any{"name": "a", "n": int64(2)}, events[3].Data, "remove has the last values")
+
+	events = watchN(t, s, WatchOptions{Section: 1, Guid: other, From: 1}, 1)
+	require.Equal(t, other, events[0].Guid)
+
+	events = watchN(t, s, WatchOptions{Section: 1, Filter: "n >= 2", From: 1}, 3)
+	require.Equal(t, []uint64{2, 4, 5}, []uint64{events[0].Seq, events[1].Seq, events[2].Seq})
+
+	events = watchN(t, s, WatchOptions{Section: 1, From: 4}, 1)
+	require.Equal(t, UpdateOperation, events[0].Operation, "resumed from the sequence number")
+
+	err = s.Watch(context.Background(), WatchOptions{Section: 1, Filter: "n >"}, func(Event) error { return nil })
+	require.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func Test_stash_Watch_live(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	v := s.Snapshot()
+	from := v.Seq() + 1
+	v.Close()
+
+	done := make(chan []Event)
+	go func() {
+		done <- watchN(t, s, WatchOptions{Section: 1, From: from}, 3)
+	}()
+
+	guid, err := s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+
+	tx := s.Begin()
+	require.NoError(t, tx.Update(1, guid, map[string]any{"n": int64(2)}))
+	_, err = tx.Insert(1, map[string]any{"n": int64(3)})
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	events := <-done
+	require.Equal(t, guid, events[0].Guid)
+	require.Equal(t, events[1].Seq, events[2].Seq, "events of the transaction have the same sequence number")
+	require.Greater(t, events[1].Seq, events[0].Seq)
+}
+
+func Test_stash_Watch_close(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- s.Watch(context.Background(), WatchOptions{Section: 1}, func(Event) error { return nil })
+	}()
+	_, err = s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	select {
+	case err = <-done:
+		require.ErrorIs(t, err, ErrClosed)
+	case <-time.After(time.Second):
+		t.Fatal("watcher is not stopped by Close")
+	}
+	err = s.Watch(context.Background(), WatchOptions{Section: 1}, func(Event) error { return nil })
+	require.ErrorIs(t, err, ErrClosed, "watch of the closed stash")
+}
+
+func Test_stash_Watch_tooOld(t *testing.T) {
+	s, err := NewStash(getTestLogger())
+	require.NoError(t, err)
+
+	_, err = s.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+	s.feed.reset(s.seq)
+
+	err = s.Watch(context.Background(), WatchOptions{Section: 1, From: 1}, func(Event) error { return nil })
+	require.ErrorIs(t, err, ErrWatchTooOld)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.Watch(ctx, WatchOptions{Section: 1, From: 2}, func(Event) error { return nil })
+	require.ErrorIs(t, err, context.Canceled)
+}
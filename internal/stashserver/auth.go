@@ -0,0 +1,133 @@
+package stashserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+	// loginMethod is the only method available without the session
+	loginMethod = "/grpcs.Stash/Login"
+)
+
+type userContextKey struct{}
+
+// contextUser returns the user authenticated by the interceptor
+func contextUser(ctx context.Context) string {
+	user, _ := ctx.Value(userContextKey{}).(string)
+	return user
+}
+
+// byCaller makes the user of the call the author of the change
+func byCaller(ctx context.Context) stashdb.WriteOption {
+	return stashdb.ByUser(contextUser(ctx))
+}
+
+// contextToken returns the session token from the call metadata
+func contextToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, v := range md.Get(authorizationHeader) {
+		if strings.HasPrefix(v, bearerPrefix) {
+			return strings.TrimPrefix(v, bearerPrefix), true
+		}
+	}
+	return "", false
+}
+
+// authenticate returns the context with the user of the session token,
+// calls without the token are made by the user of the verified client certificate if there is one
+func (ss *StashServer) authenticate(ctx context.Context) (context.Context, error) {
+	token, ok := contextToken(ctx)
+	if !ok {
+		if user, ok := certUser(ctx); ok {
+			if !ss.stash.HasUser(user) {
+				return nil, status.Errorf(codes.Unauthenticated, "unknown certificate user %s", user)
+			}
+			return context.WithValue(ctx, userContextKey{}, user), nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing session token")
+	}
+	user, err := ss.stash.Session(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return context.WithValue(ctx, userContextKey{}, user), nil
+}
+
+// unaryAuth rejects calls without the valid session token except Login and calls not allowed to the user
+func (ss *StashServer) unaryAuth(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if info.FullMethod == loginMethod {
+		return handler(ctx, req)
+	}
+	ctx, err := ss.authenticate(ctx)
+	if err != nil {
+		ss.sugar.Infow("unauthenticated", "method", info.FullMethod, "err", err)
+		return nil, err
+	}
+	if err = ss.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamAuth rejects streams without the valid session token,
+// requests of the stream are authorized on receiving
+func (ss *StashServer) streamAuth(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := ss.authenticate(stream.Context())
+	if err != nil {
+		ss.sugar.Infow("unauthenticated", "method", info.FullMethod, "err", err)
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: stream, ctx: ctx, method: info.FullMethod, ss: ss})
+}
+
+// authStream passes the context with the user to the stream handler and authorizes received requests
+type authStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	method string
+	ss     *StashServer
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.ss.authorize(s.ctx, s.method, m)
+}
+
+func (ss *StashServer) Login(ctx context.Context, in *grpcproto.LoginRequest) (*grpcproto.LoginResponse, error) {
+	token, err := ss.stash.Login(in.GetUser(), in.GetPassword())
+	if errors.Is(err, stashdb.ErrInvalidCredentials) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &grpcproto.LoginResponse{Token: token}, nil
+}
+
+func (ss *StashServer) Logout(ctx context.Context, in *grpcproto.LogoutRequest) (*grpcproto.LogoutResponse, error) {
+	if token, ok := contextToken(ctx); ok {
+		ss.stash.Logout(token)
+	}
+	return &grpcproto.LogoutResponse{}, nil
+}
@@ -0,0 +1,177 @@
+package stashserver
+
+import (
+	"context"
+	"log"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+var (
+	once   sync.Once
+	logger *zap.Logger
+)
+
+func getTestLogger() *zap.Logger {
+	once.Do(func() {
+		var err error
+		logger, err = zap.NewDevelopment()
+		if err != nil {
+			log.Fatal(err)
+		}
+	})
+
+	return logger
+}
+
+// newTestServer starts the server over the in-memory connection, dial connects to it with
+// insecure credentials if no other transport credentials are given
+func newTestServer(t *testing.T, stash *stashdb.Stash, opts ...Option) (dial func(...grpc.DialOption) (grpcproto.StashClient, error)) {
+	return serveTestServer(t, NewStashServer(stash, getTestLogger(), opts...))
+}
+
+// serveTestServer starts ss over the in-memory connection, see newTestServer
+func serveTestServer(t *testing.T, ss *StashServer) (dial func(...grpc.DialOption) (grpcproto.StashClient, error)) {
+	var err error
+	ss.gserv, err = ss.newGRPCServer()
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = ss.gserv.Serve(lis)
+	}()
+	t.Cleanup(ss.Stop)
+
+	return func(dialOpts ...grpc.DialOption) (grpcproto.StashClient, error) {
+		dialOpts = append([]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+		}, dialOpts...)
+		conn, err := grpc.Dial("bufnet", dialOpts...)
+		if err != nil {
+			return nil, err
+		}
+		t.Cleanup(func() { _ = conn.Close() })
+		return grpcproto.NewStashClient(conn), nil
+	}
+}
+
+// newTestClient starts the server and connects to it without TLS
+func newTestClient(t *testing.T, stash *stashdb.Stash) grpcproto.StashClient {
+	c, err := newTestServer(t, stash)()
+	require.NoError(t, err)
+	return c
+}
+
+// login returns the context with the session token of the user
+func login(t *testing.T, c grpcproto.StashClient, user, password string) context.Context {
+	resp, err := c.Login(context.Background(), &grpcproto.LoginRequest{User: user, Password: password})
+	require.NoError(t, err)
+	return withToken(resp.GetToken())
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, bearerPrefix+token)
+}
+
+func newTestStash(t *testing.T, opts ...stashdb.Option) *stashdb.Stash {
+	stash, err := stashdb.NewStash(getTestLogger(), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = stash.Close() })
+	return stash
+}
+
+func Test_server_Login(t *testing.T) {
+	stash := newTestStash(t, stashdb.WithSessionTTL(50*time.Millisecond))
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleReader))
+	c := newTestClient(t, stash)
+
+	for _, tt := range []struct {
+		name     string
+		user     string
+		password string
+	}{
+		{"wrong password", "bob", "wrong"},
+		{"unknown user", "nobody", "secret"},
+		{"empty password", "bob", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Login(context.Background(), &grpcproto.LoginRequest{User: tt.user, Password: tt.password})
+			require.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+
+	ctx := login(t, c, "bob", "secret")
+	_, err := c.Get(ctx, &grpcproto.GetRequest{Section: 1, Guid: "missing"})
+	require.NoError(t, err)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = c.Get(ctx, &grpcproto.GetRequest{Section: 1, Guid: "missing"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "expired token")
+
+	ctx = login(t, c, "bob", "secret")
+	_, err = c.Logout(ctx, &grpcproto.LogoutRequest{})
+	require.NoError(t, err)
+	_, err = c.Get(ctx, &grpcproto.GetRequest{Section: 1, Guid: "missing"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "token after logout")
+}
+
+func Test_server_unauthenticated(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", stashdb.AllSections, stashdb.RoleAdmin))
+	c := newTestClient(t, stash)
+
+	for _, tt := range []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"no token", context.Background()},
+		{"unknown token", withToken("unknown")},
+		{"not bearer", metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, "secret")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Insert(tt.ctx, &grpcproto.InsertRequest{Section: 1})
+			require.Equal(t, codes.Unauthenticated, status.Code(err), "unary")
+
+			_, err = c.ListUsers(tt.ctx, &grpcproto.ListUsersRequest{})
+			require.Equal(t, codes.Unauthenticated, status.Code(err), "unary without section")
+
+			scan, err := c.Scan(tt.ctx, &grpcproto.ScanRequest{Section: 1})
+			require.NoError(t, err)
+			_, err = scan.Recv()
+			require.Equal(t, codes.Unauthenticated, status.Code(err), "stream")
+
+			watch, err := c.Watch(tt.ctx, &grpcproto.WatchRequest{Section: 1})
+			require.NoError(t, err)
+			_, err = watch.Recv()
+			require.Equal(t, codes.Unauthenticated, status.Code(err), "stream")
+		})
+	}
+
+	records, err := stash.Find(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.Empty(t, records, "rejected calls change nothing")
+
+	ctx := login(t, c, "bob", "secret")
+	resp, err := c.Insert(ctx, &grpcproto.InsertRequest{Section: 1})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetError())
+}
@@ -2,50 +2,140 @@ package stashserver
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"ourstash/internal/grpcproto"
 	"ourstash/internal/stashdb"
 )
 
+// scanBatchSize the number of records read under one lock by Scan
+const scanBatchSize = 100
+
+// DefaultAddress the address the server listens on without WithAddress
+const DefaultAddress = "127.0.0.1:3200"
+
 type StashServer struct {
 	grpcproto.UnimplementedStashServer
 
 	stash *stashdb.Stash
 	sugar *zap.SugaredLogger
 	gserv *grpc.Server
+	opts  options
+}
+
+type options struct {
+	address              string
+	maxMessageSize       int
+	maxConcurrentStreams uint32
+	certFile             string
+	keyFile              string
+	clientCAFile         string
+}
+
+// Option configures the StashServer
+type Option func(*options)
+
+// WithAddress sets the address the server listens on, DefaultAddress if not set
+func WithAddress(address string) Option {
+	return func(o *options) {
+		o.address = address
+	}
+}
+
+// WithLimits sets the maximum size of requests and responses in bytes and the maximum number
+// of concurrent calls of one connection, 0 - the grpc default
+func WithLimits(maxMessageSize int, maxConcurrentStreams uint32) Option {
+	return func(o *options) {
+		o.maxMessageSize = maxMessageSize
+		o.maxConcurrentStreams = maxConcurrentStreams
+	}
 }
 
-func NewStashServer(stash *stashdb.Stash, logger *zap.Logger) *StashServer {
+func NewStashServer(stash *stashdb.Stash, logger *zap.Logger, opts ...Option) *StashServer {
+	o := options{address: DefaultAddress}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &StashServer{
 		stash: stash,
 		sugar: logger.Sugar(),
+		opts:  o,
 	}
 }
 
 func (ss *StashServer) Start() error {
-	listen, err := net.Listen("tcp", "127.0.0.1:3200")
-	if err != nil {
+	var err error
+	if ss.gserv, err = ss.newGRPCServer(); err != nil {
 		return err
 	}
 
-	ss.gserv = grpc.NewServer()
-	grpcproto.RegisterStashServer(ss.gserv, ss)
-	ss.sugar.Infow("gprcserver start")
+	listen, err := net.Listen("tcp", ss.opts.address)
+	if err != nil {
+		return err
+	}
+	ss.sugar.Infow("gprcserver start", "address", ss.opts.address, "tls", ss.opts.certFile != "", "mtls", ss.opts.clientCAFile != "")
 
 	return ss.gserv.Serve(listen)
 }
 
+// newGRPCServer returns the grpc server with the stash service, interceptors and options of the StashServer
+func (ss *StashServer) newGRPCServer() (*grpc.Server, error) {
+	creds, err := ss.opts.serverCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(ss.unaryAuth),
+		grpc.StreamInterceptor(ss.streamAuth),
+	}
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	if ss.opts.maxMessageSize > 0 {
+		serverOpts = append(serverOpts,
+			grpc.MaxRecvMsgSize(ss.opts.maxMessageSize),
+			grpc.MaxSendMsgSize(ss.opts.maxMessageSize),
+		)
+	}
+	if ss.opts.maxConcurrentStreams > 0 {
+		serverOpts = append(serverOpts, grpc.MaxConcurrentStreams(ss.opts.maxConcurrentStreams))
+	}
+	gserv := grpc.NewServer(serverOpts...)
+	grpcproto.RegisterStashServer(gserv, ss)
+	return gserv, nil
+}
+
 func (ss *StashServer) GracefulStop() {
 	ss.gserv.GracefulStop()
 }
 
+// Stop closes all connections and cancels running calls, a running GracefulStop returns
+func (ss *StashServer) Stop() {
+	ss.gserv.Stop()
+}
+
+// writeOptions makes the caller the author of the change and sets the ttl of the request if it is set
+func writeOptions(ctx context.Context, ttl *durationpb.Duration) []stashdb.WriteOption {
+	opts := []stashdb.WriteOption{byCaller(ctx)}
+	if ttl != nil {
+		opts = append(opts, stashdb.WithTTL(ttl.AsDuration()))
+	}
+	return opts
+}
+
 func (ss *StashServer) Insert(ctx context.Context, in *grpcproto.InsertRequest) (*grpcproto.InsertResponse, error) {
 	var resp grpcproto.InsertResponse
 
@@ -61,7 +151,16 @@ func (ss *StashServer) Insert(ctx context.Context, in *grpcproto.InsertRequest)
 		resp.Error = err.Error()
 		return nil, err
 	}
-	resp.Guid = string(ss.stash.Insert(section, data))
+	var guid stashdb.GUIDType
+	guid, err = ss.stash.Insert(section, data, writeOptions(ctx, in.GetTtl())...)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+	resp.Guid = string(guid)
 
 	return &resp, nil
 }
@@ -76,36 +175,20 @@ func (ss *StashServer) Get(ctx context.Context, in *grpcproto.GetRequest) (*grpc
 	}
 
 	var data map[string]any
-	data, err = ss.stash.Get(section, stashdb.GUIDType(in.GetGuid()))
+	if in.AsOf != nil {
+		data, err = ss.stash.GetAsOf(section, stashdb.GUIDType(in.GetGuid()), in.AsOf.AsTime())
+	} else {
+		data, resp.Revision, err = ss.stash.GetRevision(section, stashdb.GUIDType(in.GetGuid()))
+	}
 	if err != nil {
 		resp.Error = err.Error()
 		return &resp, nil
 	}
 
-	resp.Data = make(map[string]*anypb.Any)
-	for key, val := range data {
-		switch i := val.(type) {
-		case int64:
-			a, err := anypb.New(&grpcproto.IntData{
-				Data: val.(int64),
-			})
-			if err != nil {
-				resp.Error = err.Error()
-				return &resp, err
-			}
-			resp.Data[key] = a
-		case string:
-			a, err := anypb.New(&grpcproto.StringData{
-				Data: val.(string),
-			})
-			if err != nil {
-				resp.Error = err.Error()
-				return &resp, err
-			}
-			resp.Data[key] = a
-		default:
-			ss.sugar.Warnw("get unsupported type", "value", i)
-		}
+	resp.Data, err = ss.fromStashMap(data)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, err
 	}
 	return &resp, nil
 }
@@ -125,7 +208,27 @@ func (ss *StashServer) Update(ctx context.Context, in *grpcproto.UpdateRequest)
 		resp.Error = err.Error()
 		return nil, err
 	}
-	err = ss.stash.Update(section, stashdb.GUIDType(in.Guid), data)
+	guid, revision := stashdb.GUIDType(in.Guid), in.GetExpectedRevision()
+	opts := writeOptions(ctx, in.GetTtl())
+	switch {
+	case in.GetMode() == grpcproto.UpdateMode_PATCH && revision != 0:
+		err = ss.stash.PatchIf(section, guid, data, in.GetRemoveFields(), revision, opts...)
+	case in.GetMode() == grpcproto.UpdateMode_PATCH:
+		err = ss.stash.Patch(section, guid, data, in.GetRemoveFields(), opts...)
+	case len(in.GetRemoveFields()) != 0:
+		resp.Error = "remove_fields is allowed in patch mode only"
+		return &resp, nil
+	case revision != 0:
+		err = ss.stash.UpdateIf(section, guid, data, revision, opts...)
+	default:
+		err = ss.stash.Update(section, guid, data, opts...)
+	}
+	if errors.Is(err, stashdb.ErrVersionConflict) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		resp.Error = err.Error()
 	}
@@ -142,7 +245,14 @@ func (ss *StashServer) Remove(ctx context.Context, in *grpcproto.RemoveRequest)
 		return &resp, nil
 	}
 
-	err = ss.stash.Remove(section, stashdb.GUIDType(in.GetGuid()))
+	if in.GetExpectedRevision() != 0 {
+		err = ss.stash.RemoveIf(section, stashdb.GUIDType(in.GetGuid()), in.GetExpectedRevision(), byCaller(ctx))
+	} else {
+		err = ss.stash.Remove(section, stashdb.GUIDType(in.GetGuid()), byCaller(ctx))
+	}
+	if errors.Is(err, stashdb.ErrVersionConflict) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
 	if err != nil {
 		resp.Error = err.Error()
 	}
@@ -153,6 +263,548 @@ func (ss *StashServer) Remove(ctx context.Context, in *grpcproto.RemoveRequest)
 	return &resp, nil
 }
 
+func (ss *StashServer) History(ctx context.Context, in *grpcproto.HistoryRequest) (*grpcproto.HistoryResponse, error) {
+	var resp grpcproto.HistoryResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	var versions []stashdb.Version
+	versions, err = ss.stash.History(section, stashdb.GUIDType(in.GetGuid()))
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	resp.Versions, err = ss.toProtoVersions(versions)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, err
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Revert(ctx context.Context, in *grpcproto.RevertRequest) (*grpcproto.RevertResponse, error) {
+	var resp grpcproto.RevertResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	err = ss.stash.Revert(section, stashdb.GUIDType(in.GetGuid()), in.GetRevision(), byCaller(ctx))
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return &resp, nil
+}
+
+func (ss *StashServer) Restore(ctx context.Context, in *grpcproto.RestoreRequest) (*grpcproto.RestoreResponse, error) {
+	var resp grpcproto.RestoreResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	err = ss.stash.Restore(section, stashdb.GUIDType(in.GetGuid()), byCaller(ctx))
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return &resp, nil
+}
+
+func (ss *StashServer) ListDeleted(ctx context.Context, in *grpcproto.ListDeletedRequest) (*grpcproto.ListDeletedResponse, error) {
+	var resp grpcproto.ListDeletedResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	var deleted []stashdb.DeletedRecord
+	deleted, err = ss.stash.ListDeleted(section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	resp.Records = make([]*grpcproto.DeletedRecord, 0, len(deleted))
+	for _, d := range deleted {
+		resp.Records = append(resp.Records, &grpcproto.DeletedRecord{
+			Guid:    string(d.Guid),
+			Removed: timestamppb.New(d.Removed),
+		})
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) SetSectionConfig(ctx context.Context, in *grpcproto.SetSectionConfigRequest) (*grpcproto.SetSectionConfigResponse, error) {
+	var resp grpcproto.SetSectionConfigResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	err = ss.stash.SetSectionConfig(section, stashdb.SectionConfig{
+		Versioning:     stashdb.VersioningMode(in.GetConfig().GetVersioning()),
+		MaxVersions:    in.GetConfig().GetMaxVersions(),
+		Retention:      in.GetConfig().GetRetention().AsDuration(),
+		TombstoneGrace: in.GetConfig().GetTombstoneGrace().AsDuration(),
+		TTL:            in.GetConfig().GetTtl().AsDuration(),
+	})
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return &resp, nil
+}
+
+func (ss *StashServer) GetSectionConfig(ctx context.Context, in *grpcproto.GetSectionConfigRequest) (*grpcproto.GetSectionConfigResponse, error) {
+	var resp grpcproto.GetSectionConfigResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	cfg := ss.stash.SectionConfig(section)
+	resp.Config = &grpcproto.SectionConfig{
+		Versioning:     grpcproto.Versioning(cfg.Versioning),
+		MaxVersions:    cfg.MaxVersions,
+		Retention:      durationpb.New(cfg.Retention),
+		TombstoneGrace: durationpb.New(cfg.TombstoneGrace),
+		Ttl:            durationpb.New(cfg.TTL),
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Compact(ctx context.Context, in *grpcproto.CompactRequest) (*grpcproto.CompactResponse, error) {
+	var resp grpcproto.CompactResponse
+
+	stats, err := ss.stash.Compact()
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	metrics := ss.stash.CompactMetrics()
+	resp.Stats = &grpcproto.CompactStats{
+		Versions:   uint64(stats.Versions),
+		Tombstones: uint64(stats.Tombstones),
+		Keys:       uint64(stats.Keys),
+		Duration:   durationpb.New(stats.Duration),
+	}
+	resp.TotalRuns = metrics.Runs
+	resp.TotalKeys = metrics.Keys
+	return &resp, nil
+}
+
+func (ss *StashServer) Transaction(ctx context.Context, in *grpcproto.TransactionRequest) (*grpcproto.TransactionResponse, error) {
+	var resp grpcproto.TransactionResponse
+
+	tx := ss.stash.Begin(byCaller(ctx))
+	guids, err := ss.applyTx(tx, in.GetOperations())
+	if err != nil {
+		_ = tx.Rollback()
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	err = tx.Commit()
+	if errors.Is(err, stashdb.ErrTxConflict) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	resp.Guids = guids
+	return &resp, nil
+}
+
+func (ss *StashServer) applyTx(tx *stashdb.Tx, ops []*grpcproto.TxOperation) ([]string, error) {
+	guids := make([]string, 0, len(ops))
+	for i, op := range ops {
+		section, err := ss.getSection(op.GetSection())
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		guid := stashdb.GUIDType(op.GetGuid())
+		var data map[string]any
+		switch op.GetKind() {
+		case grpcproto.TxOperationKind_TX_INSERT:
+			if data, err = ss.toStashMap(op.GetData()); err == nil {
+				guid, err = tx.Insert(section, data)
+			}
+		case grpcproto.TxOperationKind_TX_UPDATE:
+			if data, err = ss.toStashMap(op.GetData()); err == nil {
+				err = tx.Update(section, guid, data)
+			}
+		case grpcproto.TxOperationKind_TX_REMOVE:
+			err = tx.Remove(section, guid)
+		default:
+			err = fmt.Errorf("unknown kind %v", op.GetKind())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		guids = append(guids, string(guid))
+	}
+	return guids, nil
+}
+
+func (ss *StashServer) CreateIndex(ctx context.Context, in *grpcproto.CreateIndexRequest) (*grpcproto.CreateIndexResponse, error) {
+	var resp grpcproto.CreateIndexResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	switch {
+	case in.GetText():
+		err = ss.stash.CreateTextIndex(section, in.GetField())
+	case in.GetUnique():
+		err = ss.stash.CreateUniqueIndex(section, in.GetField())
+	default:
+		err = ss.stash.CreateIndex(section, in.GetField())
+	}
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return &resp, nil
+}
+
+func (ss *StashServer) DropIndex(ctx context.Context, in *grpcproto.DropIndexRequest) (*grpcproto.DropIndexResponse, error) {
+	var resp grpcproto.DropIndexResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	if in.GetText() {
+		err = ss.stash.DropTextIndex(section, in.GetField())
+	} else {
+		err = ss.stash.DropIndex(section, in.GetField())
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return &resp, nil
+}
+
+func (ss *StashServer) ListIndexes(ctx context.Context, in *grpcproto.ListIndexesRequest) (*grpcproto.ListIndexesResponse, error) {
+	var resp grpcproto.ListIndexesResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	for _, idx := range ss.stash.ListIndexes(section) {
+		resp.Indexes = append(resp.Indexes, &grpcproto.Index{
+			Field:  idx.Field,
+			Unique: idx.Unique,
+			Text:   idx.Text,
+		})
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Find(ctx context.Context, in *grpcproto.FindRequest) (*grpcproto.FindResponse, error) {
+	var resp grpcproto.FindResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	records, err := ss.stash.Query(ctx, section, stashdb.Query{
+		Filter:  in.GetFilter(),
+		Fields:  in.GetFields(),
+		Limit:   int(in.GetLimit()),
+		OrderBy: in.GetOrderBy(),
+		Desc:    in.GetDesc(),
+	})
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	resp.Records = make([]*grpcproto.Record, 0, len(records))
+	for _, rec := range records {
+		data, err := ss.fromStashMap(rec.Data())
+		if err != nil {
+			return nil, err
+		}
+		resp.Records = append(resp.Records, &grpcproto.Record{
+			Guid: string(rec.Guid()),
+			Data: data,
+		})
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Scan(in *grpcproto.ScanRequest, stream grpcproto.Stash_ScanServer) error {
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		return stream.Send(&grpcproto.ScanResponse{Error: err.Error()})
+	}
+	after, err := decodeScanToken(section, in.GetToken())
+	if err != nil {
+		return stream.Send(&grpcproto.ScanResponse{Error: err.Error()})
+	}
+
+	limit, sent, done := int(in.GetLimit()), 0, false
+	for !done && (limit == 0 || sent < limit) {
+		if err = stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		batch := scanBatchSize
+		if limit > 0 && limit-sent < batch {
+			batch = limit - sent
+		}
+		var records []stashdb.Record
+		records, after, err = ss.stash.ScanBatch(section, after, batch)
+		if err != nil {
+			return stream.Send(&grpcproto.ScanResponse{Error: err.Error()})
+		}
+		done = len(records) < batch
+
+		for _, rec := range records {
+			data, err := ss.fromStashMap(rec.Data())
+			if err != nil {
+				return err
+			}
+			err = stream.Send(&grpcproto.ScanResponse{
+				Record: &grpcproto.Record{Guid: string(rec.Guid()), Data: data},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		sent += len(records)
+	}
+
+	return stream.Send(&grpcproto.ScanResponse{
+		Token: encodeScanToken(section, after),
+		Done:  done,
+	})
+}
+
+func (ss *StashServer) Aggregate(ctx context.Context, in *grpcproto.AggregateRequest) (*grpcproto.AggregateResponse, error) {
+	var resp grpcproto.AggregateResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	aggs := make([]stashdb.Aggregation, 0, len(in.GetAggregations()))
+	for _, a := range in.GetAggregations() {
+		aggs = append(aggs, stashdb.Aggregation{
+			Func:  stashdb.AggregateFunc(a.GetFunc()),
+			Field: a.GetField(),
+		})
+	}
+
+	results, err := ss.stash.Aggregate(ctx, section, in.GetFilter(), aggs, in.GetGroupBy())
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	resp.Groups = make([]*grpcproto.AggregateGroup, 0, len(results))
+	for _, res := range results {
+		// missing group-by fields are left out of the group
+		for field, value := range res.Group {
+			if value == nil {
+				delete(res.Group, field)
+			}
+		}
+		group, err := ss.fromStashMap(res.Group)
+		if err != nil {
+			return nil, err
+		}
+		resp.Groups = append(resp.Groups, &grpcproto.AggregateGroup{
+			Group:  group,
+			Count:  uint64(res.Count),
+			Values: res.Values,
+		})
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Search(ctx context.Context, in *grpcproto.SearchRequest) (*grpcproto.SearchResponse, error) {
+	var resp grpcproto.SearchResponse
+
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+
+	results, err := ss.stash.Search(section, in.GetField(), in.GetQuery())
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+	if limit := int(in.GetLimit()); limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	resp.Hits = make([]*grpcproto.SearchHit, 0, len(results))
+	for _, res := range results {
+		resp.Hits = append(resp.Hits, &grpcproto.SearchHit{
+			Guid:  string(res.Guid),
+			Score: uint64(res.Score),
+		})
+	}
+	return &resp, nil
+}
+
+// Watch streams changes of the section from the requested sequence number until the client goes away
+func (ss *StashServer) Watch(in *grpcproto.WatchRequest, stream grpcproto.Stash_WatchServer) error {
+	section, err := ss.getSection(in.Section)
+	if err != nil {
+		return stream.Send(&grpcproto.WatchResponse{Error: err.Error()})
+	}
+
+	opts := stashdb.WatchOptions{
+		Section: section,
+		Guid:    stashdb.GUIDType(in.GetGuid()),
+		Filter:  in.GetFilter(),
+		From:    in.GetFromSeq(),
+	}
+	err = ss.stash.Watch(stream.Context(), opts, func(e stashdb.Event) error {
+		data, err := ss.fromStashMap(e.Data)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&grpcproto.WatchResponse{
+			Seq:       e.Seq,
+			Guid:      string(e.Guid),
+			Operation: string(e.Operation),
+			Data:      data,
+			Time:      timestamppb.New(e.Time),
+		})
+	})
+	switch {
+	case errors.Is(err, stashdb.ErrWatchTooOld):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, stashdb.ErrClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, stashdb.ErrInvalidFilter):
+		return stream.Send(&grpcproto.WatchResponse{Error: err.Error()})
+	}
+	return err
+}
+
+func (ss *StashServer) Audit(ctx context.Context, in *grpcproto.AuditRequest) (*grpcproto.AuditResponse, error) {
+	var resp grpcproto.AuditResponse
+
+	// 0 is all sections
+	if in.GetSection() > 254 {
+		resp.Error = "section must be in [0 ... 254]"
+		return &resp, nil
+	}
+	q := stashdb.AuditQuery{
+		Section: stashdb.SectionIdType(in.GetSection()),
+		User:    in.GetUser(),
+		Limit:   int(in.GetLimit()),
+	}
+	if in.From != nil {
+		q.From = in.From.AsTime()
+	}
+	if in.To != nil {
+		q.To = in.To.AsTime()
+	}
+
+	changes, err := ss.stash.Audit(q)
+	if err != nil {
+		resp.Error = err.Error()
+		return &resp, nil
+	}
+	resp.Changes = make([]*grpcproto.Change, 0, len(changes))
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &grpcproto.Change{
+			Section:   uint32(c.Section),
+			Guid:      string(c.Guid),
+			Revision:  c.Revision,
+			Operation: string(c.Operation),
+			User:      c.User,
+			Time:      timestamppb.New(c.Time),
+		})
+	}
+	return &resp, nil
+}
+
+// encodeScanToken returns the opaque continuation token of the scan
+func encodeScanToken(section stashdb.SectionIdType, after stashdb.RecordIdType) string {
+	var b [9]byte
+	b[0] = byte(section)
+	binary.BigEndian.PutUint64(b[1:], uint64(after))
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+func decodeScanToken(section stashdb.SectionIdType, token string) (stashdb.RecordIdType, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != 9 {
+		return 0, errors.New("invalid scan token")
+	}
+	if stashdb.SectionIdType(b[0]) != section {
+		return 0, errors.New("scan token of other section")
+	}
+	return stashdb.RecordIdType(binary.BigEndian.Uint64(b[1:])), nil
+}
+
+// isUniqueViolation reports if the write was rejected by the unique constraint
+func isUniqueViolation(err error) bool {
+	var uv stashdb.ErrUniqueViolation
+	return errors.As(err, &uv)
+}
+
+// getSection checks the range of the section of the request, the access is checked by the interceptor,
+// see methodRoles
 func (ss *StashServer) getSection(in uint32) (stashdb.SectionIdType, error) {
 	if in == 0 || in > 254 {
 		return 0xff, fmt.Errorf("section must be in [1 ... 254]")
@@ -183,3 +835,51 @@ func (ss *StashServer) toStashMap(in map[string]*anypb.Any) (map[string]any, err
 
 	return out, nil
 }
+
+func (ss *StashServer) fromStashMap(in map[string]any) (map[string]*anypb.Any, error) {
+	out := make(map[string]*anypb.Any)
+	for key, val := range in {
+		switch i := val.(type) {
+		case int64:
+			a, err := anypb.New(&grpcproto.IntData{
+				Data: i,
+			})
+			if err != nil {
+				return nil, err
+			}
+			out[key] = a
+		case string:
+			a, err := anypb.New(&grpcproto.StringData{
+				Data: i,
+			})
+			if err != nil {
+				return nil, err
+			}
+			out[key] = a
+		default:
+			ss.sugar.Warnw("get unsupported type", "value", i)
+		}
+	}
+
+	return out, nil
+}
+
+func (ss *StashServer) toProtoVersions(in []stashdb.Version) ([]*grpcproto.Version, error) {
+	out := make([]*grpcproto.Version, 0, len(in))
+	for _, v := range in {
+		data, err := ss.fromStashMap(v.Data)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &grpcproto.Version{
+			Revision:  v.Revision,
+			RecordId:  uint64(v.RecordId),
+			Operation: string(v.Operation),
+			User:      v.User,
+			Time:      timestamppb.New(v.Time),
+			Deleted:   v.Deleted,
+			Data:      data,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,112 @@
+package stashserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+// stopped runs stop and reports whether it returned within the timeout
+func stopped(stop func(), timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func Test_server_Stop_watch(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleReader))
+
+	ss := NewStashServer(stash, getTestLogger())
+	c, err := serveTestServer(t, ss)()
+	require.NoError(t, err)
+	watch, err := c.Watch(login(t, c, "bob", "secret"), &grpcproto.WatchRequest{Section: 1, FromSeq: 1})
+	require.NoError(t, err)
+	_, err = stash.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+	_, err = watch.Recv()
+	require.NoError(t, err, "the stream is running")
+
+	require.False(t, stopped(ss.GracefulStop, 100*time.Millisecond), "the open stream holds GracefulStop")
+	require.True(t, stopped(ss.Stop, time.Second))
+	_, err = watch.Recv()
+	require.Error(t, err)
+}
+
+func Test_server_Watch_closed(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleReader))
+
+	ss := NewStashServer(stash, getTestLogger())
+	c, err := serveTestServer(t, ss)()
+	require.NoError(t, err)
+	watch, err := c.Watch(login(t, c, "bob", "secret"), &grpcproto.WatchRequest{Section: 1, FromSeq: 1})
+	require.NoError(t, err)
+	_, err = stash.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+	_, err = watch.Recv()
+	require.NoError(t, err)
+
+	require.NoError(t, stash.Close())
+	_, err = watch.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err), "watchers are stopped by Close")
+	require.True(t, stopped(ss.GracefulStop, time.Second), "nothing holds GracefulStop")
+}
+
+func Test_server_Update_ttl(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleWriter))
+	c := newTestClient(t, stash)
+	ctx := login(t, c, "bob", "secret")
+
+	insert := func() string {
+		resp, err := c.Insert(ctx, &grpcproto.InsertRequest{Section: 1, Ttl: durationpb.New(50 * time.Millisecond)})
+		require.NoError(t, err)
+		require.Empty(t, resp.GetError())
+		return resp.GetGuid()
+	}
+	update := func(in *grpcproto.UpdateRequest) {
+		resp, err := c.Update(ctx, in)
+		require.NoError(t, err)
+		require.Empty(t, resp.GetError())
+	}
+
+	carried := insert()
+	update(&grpcproto.UpdateRequest{Section: 1, Guid: carried, Mode: grpcproto.UpdateMode_PATCH})
+	patched := insert()
+	update(&grpcproto.UpdateRequest{Section: 1, Guid: patched, Mode: grpcproto.UpdateMode_PATCH,
+		Ttl: durationpb.New(time.Hour)})
+	conditional := insert()
+	update(&grpcproto.UpdateRequest{Section: 1, Guid: conditional, ExpectedRevision: 1,
+		Ttl: durationpb.New(time.Hour)})
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := stash.Get(1, stashdb.GUIDType(carried))
+	require.ErrorIs(t, err, stashdb.ErrRecordNotFound, "update without ttl keeps the expiration time")
+	for _, guid := range []string{patched, conditional} {
+		_, err = stash.Get(1, stashdb.GUIDType(guid))
+		require.NoError(t, err, "ttl is set by patch and conditional updates")
+	}
+
+	resp, err := c.Insert(ctx, &grpcproto.InsertRequest{Section: 1, Ttl: durationpb.New(-time.Second)})
+	require.NoError(t, err)
+	require.Contains(t, resp.GetError(), stashdb.ErrInvalidTTL.Error())
+}
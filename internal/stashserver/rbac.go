@@ -0,0 +1,158 @@
+package stashserver
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+// methodRoles the role the method needs on the section of the request, requests without the section
+// need the role on all sections. Methods missing here are denied, so a new RPC must be added.
+var methodRoles = map[string]stashdb.Role{
+	"/grpcs.Stash/Get":              stashdb.RoleReader,
+	"/grpcs.Stash/History":          stashdb.RoleReader,
+	"/grpcs.Stash/ListDeleted":      stashdb.RoleReader,
+	"/grpcs.Stash/GetSectionConfig": stashdb.RoleReader,
+	"/grpcs.Stash/ListIndexes":      stashdb.RoleReader,
+	"/grpcs.Stash/Find":             stashdb.RoleReader,
+	"/grpcs.Stash/Scan":             stashdb.RoleReader,
+	"/grpcs.Stash/Aggregate":        stashdb.RoleReader,
+	"/grpcs.Stash/Search":           stashdb.RoleReader,
+	"/grpcs.Stash/Watch":            stashdb.RoleReader,
+
+	"/grpcs.Stash/Insert":      stashdb.RoleWriter,
+	"/grpcs.Stash/Update":      stashdb.RoleWriter,
+	"/grpcs.Stash/Remove":      stashdb.RoleWriter,
+	"/grpcs.Stash/Revert":      stashdb.RoleWriter,
+	"/grpcs.Stash/Restore":     stashdb.RoleWriter,
+	"/grpcs.Stash/Transaction": stashdb.RoleWriter,
+
+	"/grpcs.Stash/SetSectionConfig": stashdb.RoleAdmin,
+	"/grpcs.Stash/CreateIndex":      stashdb.RoleAdmin,
+	"/grpcs.Stash/DropIndex":        stashdb.RoleAdmin,
+	"/grpcs.Stash/Compact":          stashdb.RoleAdmin,
+	"/grpcs.Stash/CreateUser":       stashdb.RoleAdmin,
+	"/grpcs.Stash/DeleteUser":       stashdb.RoleAdmin,
+	"/grpcs.Stash/SetPassword":      stashdb.RoleAdmin,
+	"/grpcs.Stash/ListUsers":        stashdb.RoleAdmin,
+	"/grpcs.Stash/Grant":            stashdb.RoleAdmin,
+	"/grpcs.Stash/Audit":            stashdb.RoleAdmin,
+
+	"/grpcs.Stash/Logout": stashdb.RoleNone,
+}
+
+// authorize checks that the user of the context has the role needed by the method on sections of the request
+func (ss *StashServer) authorize(ctx context.Context, method string, req any) error {
+	role, ok := methodRoles[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+	if role == stashdb.RoleNone {
+		return nil
+	}
+
+	user := contextUser(ctx)
+	for _, section := range requestSections(req) {
+		if err := ss.stash.Authorize(user, section, role); err != nil {
+			ss.sugar.Infow("permission denied", "user", user, "method", method, "section", section)
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return nil
+}
+
+// requestSections returns sections the request works with, AllSections for requests without the section
+func requestSections(req any) []stashdb.SectionIdType {
+	switch r := req.(type) {
+	case *grpcproto.TransactionRequest:
+		sections := make([]stashdb.SectionIdType, 0, len(r.GetOperations()))
+		for _, op := range r.GetOperations() {
+			sections = append(sections, toSection(op.GetSection()))
+		}
+		return sections
+	case *grpcproto.GrantRequest:
+		// the section of the grant is not the section the request works with
+		return []stashdb.SectionIdType{stashdb.AllSections}
+	case interface{ GetSection() uint32 }:
+		return []stashdb.SectionIdType{toSection(r.GetSection())}
+	}
+	return []stashdb.SectionIdType{stashdb.AllSections}
+}
+
+// toSection converts the section of the request, out of range sections need the role on all sections,
+// the handler rejects them anyway
+func toSection(in uint32) stashdb.SectionIdType {
+	if in > 0xff {
+		return stashdb.AllSections
+	}
+	return stashdb.SectionIdType(in)
+}
+
+func (ss *StashServer) CreateUser(ctx context.Context, in *grpcproto.CreateUserRequest) (*grpcproto.CreateUserResponse, error) {
+	var resp grpcproto.CreateUserResponse
+
+	if err := ss.stash.CreateUser(in.GetUser(), in.GetPassword()); err != nil {
+		resp.Error = err.Error()
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) DeleteUser(ctx context.Context, in *grpcproto.DeleteUserRequest) (*grpcproto.DeleteUserResponse, error) {
+	var resp grpcproto.DeleteUserResponse
+
+	if err := ss.stash.DeleteUser(in.GetUser()); err != nil {
+		resp.Error = err.Error()
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) SetPassword(ctx context.Context, in *grpcproto.SetPasswordRequest) (*grpcproto.SetPasswordResponse, error) {
+	var resp grpcproto.SetPasswordResponse
+
+	if err := ss.stash.SetPassword(in.GetUser(), in.GetPassword()); err != nil {
+		resp.Error = err.Error()
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) ListUsers(ctx context.Context, in *grpcproto.ListUsersRequest) (*grpcproto.ListUsersResponse, error) {
+	var resp grpcproto.ListUsersResponse
+
+	for _, name := range ss.stash.ListUsers() {
+		grants, err := ss.stash.Grants(name)
+		if err != nil {
+			// deleted meanwhile
+			continue
+		}
+		user := &grpcproto.User{Name: name}
+		for section, role := range grants {
+			user.Grants = append(user.Grants, &grpcproto.Grant{
+				Section: uint32(section),
+				Role:    grpcproto.Role(role),
+			})
+		}
+		sort.Slice(user.Grants, func(i, j int) bool { return user.Grants[i].Section < user.Grants[j].Section })
+		resp.Users = append(resp.Users, user)
+	}
+	return &resp, nil
+}
+
+func (ss *StashServer) Grant(ctx context.Context, in *grpcproto.GrantRequest) (*grpcproto.GrantResponse, error) {
+	var resp grpcproto.GrantResponse
+
+	// 0 is all sections
+	if in.GetSection() > 254 {
+		resp.Error = "section must be in [0 ... 254]"
+		return &resp, nil
+	}
+	err := ss.stash.Grant(in.GetUser(), stashdb.SectionIdType(in.GetSection()), stashdb.Role(in.GetRole()))
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return &resp, nil
+}
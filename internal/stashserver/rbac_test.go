@@ -0,0 +1,195 @@
+package stashserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+func Test_server_methodRoles(t *testing.T) {
+	desc := grpcproto.Stash_ServiceDesc
+	methods := make(map[string]bool)
+	for _, m := range desc.Methods {
+		methods["/"+desc.ServiceName+"/"+m.MethodName] = true
+	}
+	for _, s := range desc.Streams {
+		methods["/"+desc.ServiceName+"/"+s.StreamName] = true
+	}
+
+	for method := range methods {
+		if method == loginMethod {
+			continue
+		}
+		_, ok := methodRoles[method]
+		require.True(t, ok, "%s has no role", method)
+	}
+	for method := range methodRoles {
+		require.True(t, methods[method], "%s is not the method of the service", method)
+	}
+	_, ok := methodRoles[loginMethod]
+	require.False(t, ok, "login is handled before authorization")
+}
+
+func Test_server_requestSections(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		req  any
+		want []stashdb.SectionIdType
+	}{
+		{"section", &grpcproto.GetRequest{Section: 3}, []stashdb.SectionIdType{3}},
+		{"out of range", &grpcproto.GetRequest{Section: 300}, []stashdb.SectionIdType{stashdb.AllSections}},
+		{"no section", &grpcproto.ListUsersRequest{}, []stashdb.SectionIdType{stashdb.AllSections}},
+		{"grant", &grpcproto.GrantRequest{Section: 3}, []stashdb.SectionIdType{stashdb.AllSections}},
+		{"transaction", &grpcproto.TransactionRequest{Operations: []*grpcproto.TxOperation{{Section: 1}, {Section: 2}}},
+			[]stashdb.SectionIdType{1, 2}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, requestSections(tt.req))
+		})
+	}
+}
+
+func Test_server_authorize(t *testing.T) {
+	stash := newTestStash(t)
+	for _, user := range []struct {
+		name    string
+		section stashdb.SectionIdType
+		role    stashdb.Role
+	}{
+		{"reader", 1, stashdb.RoleReader},
+		{"writer", 1, stashdb.RoleWriter},
+		{"admin", stashdb.AllSections, stashdb.RoleAdmin},
+		{"nobody", 0, stashdb.RoleNone},
+	} {
+		require.NoError(t, stash.CreateUser(user.name, "secret"))
+		require.NoError(t, stash.Grant(user.name, user.section, user.role))
+	}
+	c := newTestClient(t, stash)
+
+	guid, err := stash.Insert(1, map[string]any{"n": int64(1)})
+	require.NoError(t, err)
+
+	type call func(ctx context.Context) error
+	get := func(section uint32) call {
+		return func(ctx context.Context) error {
+			_, err := c.Get(ctx, &grpcproto.GetRequest{Section: section, Guid: string(guid)})
+			return err
+		}
+	}
+	insert := func(section uint32) call {
+		return func(ctx context.Context) error {
+			_, err := c.Insert(ctx, &grpcproto.InsertRequest{Section: section})
+			return err
+		}
+	}
+	update := func(ctx context.Context) error {
+		_, err := c.Update(ctx, &grpcproto.UpdateRequest{Section: 1, Guid: string(guid)})
+		return err
+	}
+	remove := func(ctx context.Context) error {
+		_, err := c.Remove(ctx, &grpcproto.RemoveRequest{Section: 1, Guid: "missing"})
+		return err
+	}
+	tx := func(ctx context.Context) error {
+		_, err := c.Transaction(ctx, &grpcproto.TransactionRequest{Operations: []*grpcproto.TxOperation{
+			{Kind: grpcproto.TxOperationKind_TX_INSERT, Section: 1},
+			{Kind: grpcproto.TxOperationKind_TX_INSERT, Section: 2},
+		}})
+		return err
+	}
+	scan := func(section uint32) call {
+		return func(ctx context.Context) error {
+			stream, err := c.Scan(ctx, &grpcproto.ScanRequest{Section: section})
+			if err != nil {
+				return err
+			}
+			_, err = stream.Recv()
+			return err
+		}
+	}
+	listUsers := func(ctx context.Context) error {
+		_, err := c.ListUsers(ctx, &grpcproto.ListUsersRequest{})
+		return err
+	}
+	grant := func(ctx context.Context) error {
+		_, err := c.Grant(ctx, &grpcproto.GrantRequest{User: "reader", Section: 3, Role: grpcproto.Role_READER})
+		return err
+	}
+	setConfig := func(ctx context.Context) error {
+		_, err := c.SetSectionConfig(ctx, &grpcproto.SetSectionConfigRequest{Section: 1})
+		return err
+	}
+
+	for _, tt := range []struct {
+		user    string
+		name    string
+		call    call
+		allowed bool
+	}{
+		{"reader", "get", get(1), true},
+		{"reader", "scan", scan(1), true},
+		{"reader", "get other section", get(2), false},
+		{"reader", "insert", insert(1), false},
+		{"reader", "update", update, false},
+		{"reader", "remove", remove, false},
+
+		{"writer", "get", get(1), true},
+		{"writer", "insert", insert(1), true},
+		{"writer", "update", update, true},
+		{"writer", "remove", remove, true},
+		{"writer", "insert other section", insert(2), false},
+		{"writer", "get other section", get(2), false},
+		{"writer", "scan other section", scan(2), false},
+		{"writer", "transaction over two sections", tx, false},
+		{"writer", "section config", setConfig, false},
+		{"writer", "list users", listUsers, false},
+
+		{"admin", "get", get(2), true},
+		{"admin", "insert", insert(7), true},
+		{"admin", "transaction", tx, true},
+		{"admin", "section config", setConfig, true},
+		{"admin", "list users", listUsers, true},
+		{"admin", "grant", grant, true},
+
+		{"nobody", "get", get(1), false},
+		{"nobody", "scan", scan(1), false},
+		{"nobody", "grant", grant, false},
+	} {
+		t.Run(tt.user+" "+tt.name, func(t *testing.T) {
+			err := tt.call(login(t, c, tt.user, "secret"))
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
+
+func Test_server_unknownMethod(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("admin", "secret"))
+	require.NoError(t, stash.Grant("admin", stashdb.AllSections, stashdb.RoleAdmin))
+	token, err := stash.Login("admin", "secret")
+	require.NoError(t, err)
+
+	ss := NewStashServer(stash, getTestLogger())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+token))
+	called := false
+	_, err = ss.unaryAuth(ctx, &grpcproto.GetRequest{Section: 1},
+		&grpc.UnaryServerInfo{FullMethod: "/grpcs.Stash/Unknown"},
+		func(ctx context.Context, req any) (any, error) {
+			called = true
+			return nil, nil
+		})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "methods missing in methodRoles are denied even to admin")
+	require.False(t, called)
+}
@@ -0,0 +1,81 @@
+package stashserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// WithTLS makes the server accept TLS connections only with the certificate and the key from PEM files
+func WithTLS(certFile, keyFile string) Option {
+	return func(o *options) {
+		o.certFile = certFile
+		o.keyFile = keyFile
+	}
+}
+
+// WithClientCA requires client certificates signed by CAs of the PEM bundle (mutual TLS), needs WithTLS.
+// The common name of the client certificate subject is the stash user of calls without the session token.
+func WithClientCA(caFile string) Option {
+	return func(o *options) {
+		o.clientCAFile = caFile
+	}
+}
+
+// serverCredentials returns transport credentials of the options, nil - plain connections
+func (o options) serverCredentials() (credentials.TransportCredentials, error) {
+	if o.certFile == "" && o.keyFile == "" {
+		if o.clientCAFile != "" {
+			return nil, fmt.Errorf("client ca needs the server certificate")
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if o.clientCAFile != "" {
+		if config.ClientCAs, err = LoadCertPool(o.clientCAFile); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// LoadCertPool reads PEM certificates of the file into the pool
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("load ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("load ca: no certificates in %s", file)
+	}
+	return pool, nil
+}
+
+// certUser returns the common name of the verified client certificate of the call
+func certUser(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	name := info.State.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
+}
@@ -0,0 +1,200 @@
+package stashserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"ourstash/internal/grpcproto"
+	"ourstash/internal/stashdb"
+)
+
+// testCA issues certificates for tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+	dir  string
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &testCA{cert: cert, key: key, pool: x509.NewCertPool(), dir: t.TempDir()}
+	ca.pool.AddCert(cert)
+	ca.write(t, "ca.crt", "CERTIFICATE", der)
+	return ca
+}
+
+// write writes the PEM block to the file of the CA dir and returns its path
+func (ca *testCA) write(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(ca.dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
+
+// issue returns the certificate with the common name signed by the CA
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"bufnet"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// issueFiles writes the server certificate and its key and returns their paths
+func (ca *testCA) issueFiles(t *testing.T, commonName string) (string, string) {
+	cert := ca.issue(t, commonName, x509.ExtKeyUsageServerAuth)
+	keyDer, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	require.NoError(t, err)
+	return ca.write(t, commonName+".crt", "CERTIFICATE", cert.Certificate[0]),
+		ca.write(t, commonName+".key", "EC PRIVATE KEY", keyDer)
+}
+
+func tlsDial(ca *testCA, certs ...tls.Certificate) grpc.DialOption {
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:      ca.pool,
+		ServerName:   "bufnet",
+		Certificates: certs,
+	}))
+}
+
+func Test_server_mTLS(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleWriter))
+
+	ca := newTestCA(t, "ca")
+	certFile, keyFile := ca.issueFiles(t, "server")
+	dial := newTestServer(t, stash, WithTLS(certFile, keyFile), WithClientCA(filepath.Join(ca.dir, "ca.crt")))
+
+	c, err := dial(tlsDial(ca, ca.issue(t, "bob", x509.ExtKeyUsageClientAuth)))
+	require.NoError(t, err)
+	resp, err := c.Insert(context.Background(), &grpcproto.InsertRequest{Section: 1})
+	require.NoError(t, err, "the certificate user doesn't need the session")
+	require.Empty(t, resp.GetError())
+	history, err := stash.History(1, stashdb.GUIDType(resp.GetGuid()))
+	require.NoError(t, err)
+	require.Equal(t, "bob", history[0].User, "the certificate user is the author")
+
+	_, err = c.Insert(context.Background(), &grpcproto.InsertRequest{Section: 2})
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "the certificate user has its roles only")
+
+	c, err = dial(tlsDial(ca, ca.issue(t, "eve", x509.ExtKeyUsageClientAuth)))
+	require.NoError(t, err)
+	_, err = c.Insert(context.Background(), &grpcproto.InsertRequest{Section: 1})
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "unknown certificate user")
+	_, err = c.Login(context.Background(), &grpcproto.LoginRequest{User: "bob", Password: "secret"})
+	require.NoError(t, err, "any trusted client may log in")
+
+	other := newTestCA(t, "other")
+	c, err = dial(tlsDial(ca, other.issue(t, "bob", x509.ExtKeyUsageClientAuth)))
+	require.NoError(t, err)
+	_, err = c.Insert(context.Background(), &grpcproto.InsertRequest{Section: 1})
+	require.Equal(t, codes.Unavailable, status.Code(err), "certificate of the unknown CA")
+
+	c, err = dial(tlsDial(ca))
+	require.NoError(t, err)
+	_, err = c.Login(context.Background(), &grpcproto.LoginRequest{User: "bob", Password: "secret"})
+	require.Equal(t, codes.Unavailable, status.Code(err), "no client certificate")
+
+	c, err = dial()
+	require.NoError(t, err)
+	_, err = c.Login(context.Background(), &grpcproto.LoginRequest{User: "bob", Password: "secret"})
+	require.Equal(t, codes.Unavailable, status.Code(err), "plaintext client")
+}
+
+func Test_server_TLS(t *testing.T) {
+	stash := newTestStash(t)
+	require.NoError(t, stash.CreateUser("bob", "secret"))
+	require.NoError(t, stash.Grant("bob", 1, stashdb.RoleWriter))
+
+	ca := newTestCA(t, "ca")
+	certFile, keyFile := ca.issueFiles(t, "server")
+	dial := newTestServer(t, stash, WithTLS(certFile, keyFile))
+
+	c, err := dial()
+	require.NoError(t, err)
+	_, err = c.Login(context.Background(), &grpcproto.LoginRequest{User: "bob", Password: "secret"})
+	require.Equal(t, codes.Unavailable, status.Code(err), "plaintext client")
+
+	c, err = dial(tlsDial(ca))
+	require.NoError(t, err)
+	_, err = c.Insert(context.Background(), &grpcproto.InsertRequest{Section: 1})
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "no certificate and no session")
+	_, err = c.Insert(login(t, c, "bob", "secret"), &grpcproto.InsertRequest{Section: 1})
+	require.NoError(t, err)
+}
+
+func Test_server_serverCredentials(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	certFile, keyFile := ca.issueFiles(t, "server")
+
+	for _, tt := range []struct {
+		name string
+		opts []Option
+		tls  bool
+		err  bool
+	}{
+		{"plaintext", nil, false, false},
+		{"tls", []Option{WithTLS(certFile, keyFile)}, true, false},
+		{"mtls", []Option{WithTLS(certFile, keyFile), WithClientCA(filepath.Join(ca.dir, "ca.crt"))}, true, false},
+		{"client ca without certificate", []Option{WithClientCA(filepath.Join(ca.dir, "ca.crt"))}, false, true},
+		{"missing key", []Option{WithTLS(certFile, filepath.Join(ca.dir, "missing.key"))}, false, true},
+		{"client ca is not pem", []Option{WithTLS(certFile, keyFile), WithClientCA(keyFile)}, false, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var o options
+			for _, opt := range tt.opts {
+				opt(&o)
+			}
+			creds, err := o.serverCredentials()
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.tls, creds != nil)
+		})
+	}
+}